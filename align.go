@@ -0,0 +1,317 @@
+package hangulize
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hangulize/hangulize/internal/jamo"
+	"github.com/hangulize/hangulize/pkg/subword"
+)
+
+// Alignment is the correspondence between the input of a transcription and
+// the intermediate phonemes and the output syllables.
+type Alignment struct {
+	// Input is the word after transliteration and normalization without
+	// the stress marks. The segments are aligned to the byte offsets in
+	// Input.
+	Input string `json:"input"`
+
+	// Phonemes are the chunks of the rewritten word, which is the input of
+	// the "transcribe" step.
+	Phonemes []Segment `json:"phonemes"`
+
+	// Syllables are the letters in the result before localization. Most of
+	// them are Hangul syllables.
+	Syllables []Segment `json:"syllables"`
+
+	// Words are the words in the original word before transliteration
+	// aligned to Input. A syllable comes from the word which overlaps with
+	// it. They are reported only if the spec uses a single Translit which
+	// implements SpanTranslit.
+	Words []Segment `json:"words,omitempty"`
+}
+
+// Segment is a chunk of text aligned to a span of the input.
+type Segment struct {
+	Text string `json:"text"`
+
+	// Start and Stop are the byte offsets in the input. They are the same
+	// if the segment has been inserted without any source letters.
+	Start int `json:"start"`
+	Stop  int `json:"stop"`
+
+	// Stressed reports whether the syllable comes from a vowel which the
+	// Translit has marked with StressMark.
+	Stressed bool `json:"stressed,omitempty"`
+
+	// Long reports whether the syllable comes from a long vowel. It is
+	// reported only with LongVowelsMarked.
+	Long bool `json:"long,omitempty"`
+}
+
+// Align transcribes a word and aligns the result with the input. The phrases
+// in the phrase dictionary of the spec are not matched.
+func Align(h Hangulizer, word string) (*Alignment, error) {
+	var p *procedure
+	if in, ok := h.(introspectable); ok {
+		p = in.procedure()
+	} else {
+		p = newProcedure(h.Spec(), h.Translits(), nil)
+	}
+	p.track = true
+
+	return p.align(word)
+}
+
+// align runs the Hangulize procedure for a word tracking the origins of the
+// subwords.
+func (p procedure) align(word string) (*Alignment, error) {
+	original := word
+
+	word, spans, err := p.transliterateSpans(word)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized string
+	if spans != nil {
+		normalized, spans = p.normalizeSpans(word, spans)
+	} else {
+		normalized = p.normalize(word)
+	}
+	input, stresses := p.stripStress(normalized)
+	words := alignWords(original, normalized, spans)
+
+	subwords := p.partition(input)
+	subwords = p.rewrite(subwords)
+	phonemes := alignPhonemes(subwords)
+
+	subwords = p.transcribe(subwords)
+	subwords = p.applyLongVowels(subwords, input)
+	subwords = p.applyFinals(subwords, input)
+	syllables := p.alignSyllables(subwords)
+	markStressed(syllables, stresses)
+	if p.longVowels == LongVowelsMarked {
+		markLong(syllables, findLongVowels(input))
+	}
+
+	if err := p.budget.Err(); err != nil {
+		return nil, err
+	}
+	return &Alignment{input, phonemes, syllables, words}, nil
+}
+
+// usesOptionalTranslit reports whether any optional Translit of the spec is
+// imported.
+func (p procedure) usesOptionalTranslit() bool {
+	for _, scheme := range p.spec.Lang.OptionalTranslit {
+		if _, ok := p.translits[scheme]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// transliterateSpans is transliterate which also reports the spans of the
+// result if the spec uses a single Translit which implements SpanTranslit.
+// Otherwise, the spans are nil.
+func (p procedure) transliterateSpans(word string) (string, []TranslitSpan, error) {
+	if len(p.spec.Lang.Translit) != 1 || p.usesOptionalTranslit() {
+		word, err := p.transliterate(word)
+		return word, nil, err
+	}
+
+	scheme := p.spec.Lang.Translit[0]
+	t, ok := p.translits[scheme].(SpanTranslit)
+	if !ok {
+		word, err := p.transliterate(word)
+		return word, nil, err
+	}
+
+	word, spans, err := t.TransliterateSpans(word)
+	if err != nil {
+		return word, nil, fmt.Errorf("%w: %s: %w", ErrTranslit, scheme, err)
+	}
+
+	p.tracer.Transliterate(word, t.Scheme())
+	return word, spans, nil
+}
+
+// normalizeSpans normalizes a transliterated word chunk by chunk between the
+// edges of the spans so that the spans follow the normalized word.
+func (p procedure) normalizeSpans(word string, spans []TranslitSpan) (string, []TranslitSpan) {
+	edges := make([]int, 0, len(spans)*2+1)
+	for _, span := range spans {
+		edges = append(edges, span.OutStart, span.OutStop)
+	}
+	edges = append(edges, len(word))
+
+	var buf strings.Builder
+	moved := map[int]int{0: 0}
+	last := 0
+
+	for _, edge := range edges {
+		if edge < last {
+			continue
+		}
+		chunk := p.spec.normReplacer.Replace(word[last:edge])
+		buf.WriteString(p.normalizeScript(chunk))
+		moved[edge] = buf.Len()
+		last = edge
+	}
+
+	normalized := buf.String()
+	p.tracer.Normalize(normalized, p.spec.Lang.Script)
+
+	result := make([]TranslitSpan, len(spans))
+	for i, span := range spans {
+		span.OutStart, span.OutStop = moved[span.OutStart], moved[span.OutStop]
+		result[i] = span
+	}
+	return normalized, result
+}
+
+// alignWords aligns the words in the original word to the input without the
+// stress marks by the spans in the normalized word.
+func alignWords(original, normalized string, spans []TranslitSpan) []Segment {
+	if spans == nil {
+		return nil
+	}
+
+	// The offsets move back by the stress marks stripped before them.
+	unstress := func(offset int) int {
+		marks := strings.Count(normalized[:offset], string(StressMark))
+		return offset - marks*utf8.RuneLen(StressMark)
+	}
+
+	words := make([]Segment, len(spans))
+	for i, span := range spans {
+		words[i] = Segment{
+			Text:  original[span.Start:span.Stop],
+			Start: unstress(span.OutStart),
+			Stop:  unstress(span.OutStop),
+		}
+	}
+	return words
+}
+
+// alignPhonemes splits the rewritten subwords into segments by the origins.
+// The spaces and the meaningless subwords are excluded.
+func alignPhonemes(subwords []subword.Subword) []Segment {
+	var segs []Segment
+
+	for _, sw := range subwords {
+		if sw.Level == 0 {
+			continue
+		}
+
+		for i, ch := range sw.Word {
+			if unicode.IsSpace(ch) {
+				continue
+			}
+
+			origin := sw.Origins[i]
+			text := string(ch)
+
+			// Merge into the previous segment from the same origin.
+			if n := len(segs); n != 0 && i != 0 && sw.Origins[i-1] == origin &&
+				segs[n-1].Start == origin.Start && segs[n-1].Stop == origin.Stop {
+				segs[n-1].Text += text
+				continue
+			}
+
+			segs = append(segs, Segment{Text: text, Start: origin.Start, Stop: origin.Stop})
+		}
+	}
+
+	return segs
+}
+
+// alignSyllables composes the transcribed subwords into Hangul syllables and
+// aligns each letter in the result. The spaces and ZWSPs are excluded.
+func (p procedure) alignSyllables(subwords []subword.Subword) []Segment {
+	var segs []Segment
+
+	var jamoBuf strings.Builder
+	var origins []subword.Span
+
+	flush := func() {
+		segs = append(segs, p.composeAligned(p.epenthesize(jamoBuf.String(), origins))...)
+		jamoBuf.Reset()
+		origins = nil
+	}
+
+	for _, sw := range subwords {
+		if sw.Level != 0 {
+			jamoBuf.WriteString(sw.Word)
+			origins = append(origins, sw.Origins...)
+			continue
+		}
+
+		flush()
+		for i, ch := range sw.Word {
+			if unicode.IsSpace(ch) || ch == '\u200B' {
+				continue
+			}
+			segs = append(segs, Segment{Text: string(ch), Start: sw.Origins[i].Start, Stop: sw.Origins[i].Stop})
+		}
+	}
+	flush()
+
+	return segs
+}
+
+// composeAligned composes Jamo phonemes into Hangul syllables. The origin of
+// a syllable is the union of the origins of its Jamo.
+func (p procedure) composeAligned(word string, origins []subword.Span) []Segment {
+	if word == "" {
+		return nil
+	}
+
+	opts := p.jamoOptions()
+	letters := []rune(jamo.ComposeHangul(word, opts...))
+	spans := make([]*subword.Span, len(letters))
+
+	// A Jamo belongs to the last letter composed so far.
+	for i := range word {
+		_, size := utf8.DecodeRuneInString(word[i:])
+
+		n := utf8.RuneCountInString(jamo.ComposeHangul(word[:i+size], opts...))
+		if n == 0 {
+			continue
+		}
+		if n > len(letters) {
+			n = len(letters)
+		}
+
+		span := origins[i]
+		if s := spans[n-1]; s != nil {
+			span = s.Union(span)
+		}
+		spans[n-1] = &span
+	}
+
+	var segs []Segment
+	for i, letter := range letters {
+		if unicode.IsSpace(letter) || spans[i] == nil {
+			continue
+		}
+		segs = append(segs, Segment{Text: string(letter), Start: spans[i].Start, Stop: spans[i].Stop})
+	}
+	return segs
+}
+
+// spanOf finds the span which covers all the origins.
+func spanOf(origins []subword.Span) subword.Span {
+	if len(origins) == 0 {
+		return subword.Span{}
+	}
+
+	span := origins[0]
+	for _, origin := range origins[1:] {
+		span = span.Union(origin)
+	}
+	return span
+}
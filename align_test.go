@@ -0,0 +1,119 @@
+package hangulize_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/english"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlign(t *testing.T) {
+	h := hangulize.New(loadSpec("ita"))
+
+	a, err := hangulize.Align(h, "Cappuccino")
+	require.NoError(t, err)
+
+	assert.Equal(t, "cappuccino", a.Input)
+	assert.Equal(t, []hangulize.Segment{
+		{Text: "카", Start: 0, Stop: 2},
+		{Text: "푸", Start: 2, Stop: 5},
+		{Text: "치", Start: 5, Stop: 8},
+		{Text: "노", Start: 8, Stop: 10},
+	}, a.Syllables)
+
+	// "pp" is a phoneme "p".
+	assert.Contains(t, a.Phonemes, hangulize.Segment{Text: "p", Start: 2, Stop: 4})
+}
+
+func TestAlignSpaces(t *testing.T) {
+	h := hangulize.New(loadSpec("deu"))
+
+	a, err := hangulize.Align(h, "Martin Luther!")
+	require.NoError(t, err)
+
+	var syllables []string
+	for _, seg := range a.Syllables {
+		syllables = append(syllables, seg.Text)
+	}
+	assert.Equal(t, []string{"마", "르", "틴", "루", "터", "!"}, syllables)
+
+	last := a.Syllables[len(a.Syllables)-1]
+	assert.Equal(t, "!", a.Input[last.Start:last.Stop])
+}
+
+func TestAlignWords(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "eng-words"
+		codes    = "en", "eng"
+		translit = "english"
+
+	transcribe:
+		"n"   -> "ㄴ"
+		"ow"  -> "ㅗ"
+		"yeh" -> "ㅖ"
+		"s"   -> "ㅅ"
+	`)
+	h := hangulize.New(spec)
+	h.UseTranslit(english.New(english.MarkStress(true)))
+
+	a, err := hangulize.Align(h, "No, yes!")
+	require.NoError(t, err)
+
+	assert.Equal(t, "now, yehs!", a.Input)
+	assert.Equal(t, []hangulize.Segment{
+		{Text: "No", Start: 0, Stop: 3},
+		{Text: "yes", Start: 5, Stop: 9},
+	}, a.Words)
+
+	// Which word each syllable comes from.
+	var words []string
+	for _, syl := range a.Syllables {
+		for _, w := range a.Words {
+			if syl.Start < w.Stop && w.Start < syl.Stop {
+				words = append(words, syl.Text+":"+w.Text)
+			}
+		}
+	}
+	assert.Equal(t, []string{"노:No", "예:yes", "스:yes"}, words)
+
+	// Not reported without SpanTranslit.
+	a, err = hangulize.Align(hangulize.New(loadSpec("ita")), "Roma")
+	require.NoError(t, err)
+	assert.Nil(t, a.Words)
+}
+
+func TestAlignJSON(t *testing.T) {
+	h := hangulize.New(loadSpec("ita"))
+
+	a, err := hangulize.Align(h, "Roma")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `{"text":"로","start":0,"stop":2}`)
+}
+
+func TestWriteTextGrid(t *testing.T) {
+	h := hangulize.New(loadSpec("ita"))
+
+	a, err := hangulize.Align(h, "Roma")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, a.WriteTextGrid(&buf))
+	grid := buf.String()
+
+	assert.True(t, strings.HasPrefix(grid, "File type = \"ooTextFile\"\nObject class = \"TextGrid\"\n"))
+	assert.Contains(t, grid, "xmax = 4\n")
+	assert.Contains(t, grid, "name = \"source\"")
+	assert.Contains(t, grid, "name = \"phoneme\"")
+	assert.Contains(t, grid, "name = \"syllable\"")
+	assert.Contains(t, grid, "            xmin = 0\n            xmax = 2\n            text = \"로\"\n")
+	assert.Contains(t, grid, "            xmin = 2\n            xmax = 4\n            text = \"마\"\n")
+}
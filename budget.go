@@ -0,0 +1,110 @@
+package hangulize
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default budgets per call of Hangulize. They are generous enough for an
+// ordinary text but stop an adversarial input from stalling the caller.
+const (
+	DefaultStepBudget = 10000000
+	DefaultTimeBudget = 10 * time.Second
+)
+
+// StepBudget limits the number of rule applications per call of Hangulize.
+// An application of a rule to a subword is a step. If n is not positive, the
+// steps are unlimited. The default is DefaultStepBudget.
+func StepBudget(n int) Option {
+	return func(o *options) {
+		if n <= 0 {
+			n = -1
+		}
+		o.stepBudget = n
+	}
+}
+
+// TimeBudget limits the time per call of Hangulize. If d is not positive,
+// the time is unlimited. The default is DefaultTimeBudget.
+func TimeBudget(d time.Duration) Option {
+	return func(o *options) {
+		if d <= 0 {
+			d = -1
+		}
+		o.timeBudget = d
+	}
+}
+
+// timeCheckInterval is the number of steps between the clock reads. Reading
+// the clock at every step would slow down the rule applications.
+const timeCheckInterval = 1024
+
+// budget tracks the remaining steps and time of a procedure.
+type budget struct {
+	steps    int
+	deadline time.Time
+
+	// spent is the number of the spent steps to read the clock only every
+	// timeCheckInterval steps.
+	spent int
+
+	err error
+}
+
+// newBudget creates a budget or nil if both are unlimited. 0 means the
+// default and a negative value means unlimited.
+func newBudget(steps int, d time.Duration) *budget {
+	if steps == 0 {
+		steps = DefaultStepBudget
+	}
+	if d == 0 {
+		d = DefaultTimeBudget
+	}
+	if steps < 0 && d < 0 {
+		return nil
+	}
+
+	b := &budget{steps: steps}
+	if d > 0 {
+		b.deadline = time.Now().Add(d)
+	}
+	return b
+}
+
+// spend consumes a step. It reports false if the budget has been exceeded.
+func (b *budget) spend() bool {
+	if b == nil {
+		return true
+	}
+	if b.err != nil {
+		return false
+	}
+
+	// A negative steps means unlimited.
+	if b.steps == 0 {
+		b.err = fmt.Errorf("%w: out of steps", ErrBudgetExceeded)
+		return false
+	}
+	if b.steps > 0 {
+		b.steps--
+	}
+
+	// The clock is read at the first step and every timeCheckInterval steps.
+	check := b.spent%timeCheckInterval == 0
+	b.spent++
+
+	if check && !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		b.err = fmt.Errorf("%w: out of time", ErrBudgetExceeded)
+		return false
+	}
+
+	return true
+}
+
+// Err returns ErrBudgetExceeded if the budget has been exceeded.
+func (b *budget) Err() error {
+	if b == nil {
+		return nil
+	}
+	return b.err
+}
@@ -0,0 +1,22 @@
+package hangulize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetTimeCheckInterval(t *testing.T) {
+	b := newBudget(-1, time.Hour)
+	assert.True(t, b.spend())
+
+	// The deadline passes but the clock is not read until the next interval.
+	b.deadline = time.Now().Add(-time.Second)
+	for i := 1; i < timeCheckInterval; i++ {
+		assert.True(t, b.spend(), "step %d", i)
+	}
+
+	assert.False(t, b.spend())
+	assert.ErrorIs(t, b.Err(), ErrBudgetExceeded)
+}
@@ -0,0 +1,62 @@
+package hangulize_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepBudget(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id     = "test"
+		codes  = "xx", "xxx"
+		script = "Latn"
+
+	rewrite:
+		"x" -> "a"
+
+	transcribe:
+		"a" -> "ㅏ"
+		"b" -> "ㅂ"
+	`)
+
+	// 3 steps are enough for 1 subword.
+	h := hangulize.New(spec, hangulize.StepBudget(3))
+	result, err := h.Hangulize("xb")
+	assert.NoError(t, err)
+	assert.Equal(t, "아브", result)
+
+	// "b" -> "ㅂ" is skipped so that "b" is not transcribed.
+	h = hangulize.New(spec, hangulize.StepBudget(2))
+	result, err = h.Hangulize("xb")
+	assert.ErrorIs(t, err, hangulize.ErrBudgetExceeded)
+	assert.Equal(t, "아", result)
+
+	h = hangulize.New(spec, hangulize.StepBudget(0))
+	_, err = h.Hangulize("xb")
+	assert.NoError(t, err)
+}
+
+func TestTimeBudget(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id     = "test"
+		codes  = "xx", "xxx"
+		script = "Latn"
+
+	transcribe:
+		"a" -> "ㅏ"
+	`)
+
+	h := hangulize.New(spec, hangulize.TimeBudget(time.Nanosecond))
+	_, err := h.Hangulize("a")
+	assert.ErrorIs(t, err, hangulize.ErrBudgetExceeded)
+
+	h = h.With(hangulize.TimeBudget(0))
+	result, err := h.Hangulize("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "아", result)
+}
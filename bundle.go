@@ -0,0 +1,334 @@
+package hangulize
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hangulize/hangulize/pkg/hsl"
+)
+
+// BundleExt is the file extension of a bundle.
+const BundleExt = ".hgb"
+
+// bundleFormat is the version of the bundle format.
+const bundleFormat = "1"
+
+// Bundle is a distributable package of a spec. A bundle file is a ZIP
+// archive which contains:
+//
+//	manifest.hsl   # "bundle" section: format, lang, translit
+//	spec.hsl       # the spec
+//	exceptions.hsl # "exceptions" section: word -> result (optional)
+//	test.hsl       # "test" section: word -> expected (optional)
+//
+// The manifest declares the Translit schemes which the spec requires. So a
+// bundle can be verified before it is installed.
+type Bundle struct {
+	Spec *Spec
+
+	// Translits are the schemes of the Translits which the spec requires.
+	Translits []string
+
+	// Exceptions are the results for the words which the rules can't
+	// transcribe properly.
+	Exceptions map[string]string
+
+	// Test is the test examples in addition to the examples in the spec.
+	Test [][2]string
+}
+
+// OpenBundle loads a bundle from a file.
+func OpenBundle(name string) (*Bundle, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadBundle(file, stat.Size())
+}
+
+// LoadBundle loads a bundle from a ZIP archive.
+func LoadBundle(r io.ReaderAt, size int64) (*Bundle, error) {
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBundle, err)
+	}
+
+	files := make(map[string]*zip.File)
+	for _, f := range z.File {
+		files[f.Name] = f
+	}
+
+	read := func(name string, required bool) (hsl.HSL, []byte, error) {
+		f, ok := files[name]
+		if !ok {
+			if required {
+				return nil, nil, fmt.Errorf("%w: no %s", ErrInvalidBundle, name)
+			}
+			return nil, nil, nil
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rc.Close()
+
+		src, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		h, err := hsl.Parse(bytes.NewReader(src))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse %s", name)
+		}
+		return h, src, nil
+	}
+
+	b := &Bundle{}
+
+	// manifest.hsl
+	h, _, err := read("manifest.hsl", true)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := dictSection(h, "bundle")
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: no bundle section in manifest.hsl", ErrInvalidBundle)
+	}
+	if format := manifest.One("format"); format != bundleFormat {
+		return nil, fmt.Errorf("%w: unsupported format: %#v", ErrInvalidBundle, format)
+	}
+	lang := manifest.One("lang")
+	b.Translits = manifest.All("translit")
+
+	// spec.hsl
+	_, src, err := read("spec.hsl", true)
+	if err != nil {
+		return nil, err
+	}
+	b.Spec, err = ParseSpec(bytes.NewReader(src))
+	if err != nil {
+		return nil, errors.Wrap(err, "spec.hsl")
+	}
+	if b.Spec.Lang.ID != lang {
+		return nil, fmt.Errorf(
+			"%w: lang %#v in manifest.hsl but %#v in spec.hsl",
+			ErrInvalidBundle, lang, b.Spec.Lang.ID,
+		)
+	}
+
+	// exceptions.hsl
+	h, _, err = read("exceptions.hsl", false)
+	if err != nil {
+		return nil, err
+	}
+	if sec, err := listSection(h, "exceptions"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		b.Exceptions = make(map[string]string)
+		for _, pair := range sec.Pairs() {
+			if len(pair.Right()) != 1 {
+				return nil, errors.Errorf("exception %#v must have one result", pair.Left())
+			}
+			b.Exceptions[pair.Left()] = pair.Right()[0]
+		}
+	}
+
+	// test.hsl
+	h, _, err = read("test.hsl", false)
+	if err != nil {
+		return nil, err
+	}
+	if sec, err := listSection(h, "test"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		for _, pair := range sec.Pairs() {
+			if len(pair.Right()) == 0 {
+				return nil, errors.Errorf("no expected result for %#v", pair.Left())
+			}
+			b.Test = append(b.Test, [2]string{pair.Left(), pair.Right()[0]})
+		}
+	}
+
+	return b, nil
+}
+
+// WriteBundle writes a bundle as a ZIP archive. The spec must have the
+// source.
+func WriteBundle(w io.Writer, b *Bundle) error {
+	if b.Spec == nil || b.Spec.Source == "" {
+		return fmt.Errorf("%w: no spec source", ErrInvalidBundle)
+	}
+
+	z := zip.NewWriter(w)
+
+	write := func(name, src string) error {
+		f, err := z.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(f, src)
+		return err
+	}
+
+	var manifest strings.Builder
+	manifest.WriteString("bundle:\n")
+	fmt.Fprintf(&manifest, "    format = %s\n", quoteFmtString(bundleFormat))
+	fmt.Fprintf(&manifest, "    lang   = %s\n", quoteFmtString(b.Spec.Lang.ID))
+	if len(b.Translits) != 0 {
+		fmt.Fprintf(&manifest, "    translit = %s\n", quoteFmtStrings(b.Translits))
+	}
+	if err := write("manifest.hsl", manifest.String()); err != nil {
+		return err
+	}
+
+	if err := write("spec.hsl", b.Spec.Source); err != nil {
+		return err
+	}
+
+	if len(b.Exceptions) != 0 {
+		words := make([]string, 0, len(b.Exceptions))
+		for word := range b.Exceptions {
+			words = append(words, word)
+		}
+		sort.Strings(words)
+
+		var buf strings.Builder
+		buf.WriteString("exceptions:\n")
+		for _, word := range words {
+			fmt.Fprintf(&buf, "    %s -> %s\n",
+				quoteFmtString(word), quoteFmtString(b.Exceptions[word]))
+		}
+		if err := write("exceptions.hsl", buf.String()); err != nil {
+			return err
+		}
+	}
+
+	if len(b.Test) != 0 {
+		var buf strings.Builder
+		buf.WriteString("test:\n")
+		for _, exm := range b.Test {
+			fmt.Fprintf(&buf, "    %s -> %s\n",
+				quoteFmtString(exm[0]), quoteFmtString(exm[1]))
+		}
+		if err := write("test.hsl", buf.String()); err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
+
+func quoteFmtStrings(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = quoteFmtString(s)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// Hangulizer creates a hangulizer for the bundled spec. It transcribes the
+// exception words into the results in the bundle without the rules.
+func (b *Bundle) Hangulizer() Hangulizer {
+	return &bundleHangulizer{New(b.Spec), b.Exceptions}
+}
+
+// Verify checks whether the bundle is installable with the Translits. The
+// Translits required by the spec must be declared in the manifest and
+// available. Then every test example in the spec and in the bundle must
+// pass.
+func (b *Bundle) Verify(translits map[string]Translit) error {
+	declared := make(map[string]bool, len(b.Translits))
+	for _, scheme := range b.Translits {
+		declared[scheme] = true
+	}
+	for _, scheme := range b.Spec.Lang.Translit {
+		if !declared[scheme] {
+			return fmt.Errorf("%w: translit not declared: %s", ErrInvalidBundle, scheme)
+		}
+	}
+
+	h := b.Hangulizer()
+	for _, scheme := range b.Translits {
+		t, ok := translits[scheme]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrTranslitMissing, scheme)
+		}
+		h.UseTranslit(t)
+	}
+
+	tests := append([][2]string{}, b.Spec.Test...)
+	tests = append(tests, b.Test...)
+
+	var failures []string
+	for _, exm := range tests {
+		word, expected := exm[0], exm[1]
+
+		result, err := h.Hangulize(word)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%#v: %s", word, err))
+			continue
+		}
+		if result != expected {
+			failures = append(failures, fmt.Sprintf(
+				"%#v -> %#v, expected: %#v", word, result, expected,
+			))
+		}
+	}
+
+	if len(failures) != 0 {
+		return fmt.Errorf("%w: test failed: %s",
+			ErrInvalidBundle, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// bundleHangulizer is a hangulizer with the exceptions in a bundle.
+type bundleHangulizer struct {
+	Hangulizer
+	exceptions map[string]string
+}
+
+func (h *bundleHangulizer) With(opts ...Option) Hangulizer {
+	return &bundleHangulizer{h.Hangulizer.With(opts...), h.exceptions}
+}
+
+func (h *bundleHangulizer) Hangulize(word string) (string, error) {
+	if result, ok := h.exceptions[word]; ok {
+		return result, nil
+	}
+	return h.Hangulizer.Hangulize(word)
+}
+
+// The underlying Hangulizer is always created by New.
+
+func (h *bundleHangulizer) effectiveOptions() options {
+	return h.Hangulizer.(introspectable).effectiveOptions()
+}
+
+func (h *bundleHangulizer) procedure() *procedure {
+	return h.Hangulizer.(introspectable).procedure()
+}
+
+func (h *bundleHangulizer) exception(word string) (string, bool) {
+	result, ok := h.exceptions[word]
+	return result, ok
+}
@@ -0,0 +1,93 @@
+package hangulize_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bundleHSL = `
+lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+transcribe:
+    "a" -> "ㅏ"
+    "b" -> "ㅂ"
+
+test:
+    "ab" -> "아브"
+`
+
+func TestBundleRoundTrip(t *testing.T) {
+	b := &hangulize.Bundle{
+		Spec:       mustParseSpec(bundleHSL),
+		Exceptions: map[string]string{"bab": "밥"},
+		Test:       [][2]string{{"ba", "바"}, {"bab", "밥"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, hangulize.WriteBundle(&buf, b))
+
+	loaded, err := hangulize.LoadBundle(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", loaded.Spec.Lang.ID)
+	assert.Equal(t, b.Exceptions, loaded.Exceptions)
+	assert.Equal(t, b.Test, loaded.Test)
+	assert.NoError(t, loaded.Verify(nil))
+
+	h := loaded.Hangulizer()
+	word, err := h.Hangulize("bab")
+	require.NoError(t, err)
+	assert.Equal(t, "밥", word)
+}
+
+func TestBundleVerifyFailure(t *testing.T) {
+	b := &hangulize.Bundle{
+		Spec: mustParseSpec(bundleHSL),
+		Test: [][2]string{{"bab", "밥"}},
+	}
+	err := b.Verify(nil)
+	assert.ErrorIs(t, err, hangulize.ErrInvalidBundle)
+	assert.Contains(t, err.Error(), `"bab" -> "바브", expected: "밥"`)
+}
+
+func TestBundleVerifyTranslit(t *testing.T) {
+	spec := mustParseSpec(`
+lang:
+    id       = "test"
+    codes    = "xx", "xxx"
+    script   = "Latn"
+    translit = "stub"
+`)
+
+	b := &hangulize.Bundle{Spec: spec}
+	assert.ErrorIs(t, b.Verify(nil), hangulize.ErrInvalidBundle)
+
+	b.Translits = []string{"stub"}
+	assert.ErrorIs(t, b.Verify(nil), hangulize.ErrTranslitMissing)
+
+	translits := map[string]hangulize.Translit{"stub": &stubTranslit{}}
+	assert.NoError(t, b.Verify(translits))
+}
+
+func TestLoadBundleInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	f, err := z.Create("manifest.hsl")
+	require.NoError(t, err)
+	f.Write([]byte("bundle:\n    format = \"1\"\n    lang = \"test\"\n"))
+	require.NoError(t, z.Close())
+
+	_, err = hangulize.LoadBundle(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.ErrorIs(t, err, hangulize.ErrInvalidBundle)
+
+	_, err = hangulize.LoadBundle(bytes.NewReader([]byte("not a zip")), 9)
+	assert.ErrorIs(t, err, hangulize.ErrInvalidBundle)
+}
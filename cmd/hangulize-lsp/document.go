@@ -0,0 +1,429 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/hre"
+	"github.com/hangulize/hangulize/pkg/hsl"
+	"github.com/hangulize/hangulize/translit"
+)
+
+// document is an opened HSL source. It is analyzed whenever its text has
+// been changed.
+type document struct {
+	uri   string
+	lines []string
+
+	// hsl is nil if the source could not be parsed as an HSL.
+	hsl hsl.HSL
+
+	// spec is nil if the source could not be parsed as a Spec.
+	spec *hangulize.Spec
+
+	// results keeps the actual results of the test examples.
+	results []string
+
+	diagnostics []diagnostic
+}
+
+// newDocument creates a document and analyzes it.
+func newDocument(uri, text string) *document {
+	d := &document{uri: uri, lines: strings.Split(text, "\n")}
+	d.analyze(text)
+	return d
+}
+
+// analyze parses the source and collects diagnostics.
+func (d *document) analyze(text string) {
+	d.diagnostics = make([]diagnostic, 0)
+
+	h, err := hsl.Parse(strings.NewReader(text))
+	if err != nil {
+		var parseErr *hsl.Error
+		if errors.As(err, &parseErr) {
+			d.report(parseErr.Line-1, severityError, parseErr.Err.Error())
+		} else {
+			d.report(0, severityError, err.Error())
+		}
+		return
+	}
+	d.hsl = h
+
+	spec, err := hangulize.ParseSpec(strings.NewReader(text))
+	if err != nil {
+		d.report(d.locateSpecError(), severityError, err.Error())
+		return
+	}
+	d.spec = spec
+
+	for _, issue := range hangulize.Lint(spec) {
+		d.report(d.issueLine(issue), severityWarning, issue.Message)
+	}
+
+	d.test()
+}
+
+// test runs the test examples in the spec and reports failures.
+func (d *document) test() {
+	h := hangulize.New(d.spec)
+	translit.Install(h)
+
+	var pairs []hsl.Pair
+	if sec, ok := d.hsl["test"]; ok {
+		pairs = sec.Pairs()
+	}
+
+	d.results = make([]string, len(d.spec.Test))
+
+	for i, exm := range d.spec.Test {
+		word, expected := exm[0], exm[1]
+		line := pairs[i].Line() - 1
+
+		result, err := safeHangulize(h, word)
+		if err != nil {
+			d.report(line, severityError, err.Error())
+			continue
+		}
+		d.results[i] = result
+
+		if result != expected {
+			msg := fmt.Sprintf(`"%s" -> "%s", expected: "%s"`, word, result, expected)
+			d.report(line, severityWarning, msg)
+		}
+	}
+}
+
+// safeHangulize transcribes a word but turns a panic into an error. A spec
+// being edited may contain rules which cannot work, such as a pattern which
+// matches an empty string.
+func safeHangulize(h hangulize.Hangulizer, word string) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return h.Hangulize(word)
+}
+
+// report appends a diagnostic which covers the whole line.
+func (d *document) report(line, severity int, msg string) {
+	if line < 0 || line >= len(d.lines) {
+		line = 0
+	}
+
+	r := textRange{
+		position{line, 0},
+		position{line, utf16Len(d.lines[line])},
+	}
+	d.diagnostics = append(d.diagnostics, diagnostic{r, severity, "hangulize", msg})
+}
+
+// locateSpecError finds the line which causes the spec to fail to be parsed.
+func (d *document) locateSpecError() int {
+	macros := d.macros()
+	vars := d.dict("vars")
+
+	for _, name := range []string{"rewrite", "transcribe"} {
+		sec, ok := d.hsl[name]
+		if !ok {
+			continue
+		}
+
+		for _, pair := range sec.Pairs() {
+			if _, err := hre.NewPattern(pair.Left(), macros, vars); err != nil {
+				return pair.Line() - 1
+			}
+		}
+	}
+
+	if sec, ok := d.hsl["lang"]; ok {
+		return sec.Line() - 1
+	}
+	return 0
+}
+
+// issueLine finds the line where an issue has been found.
+func (d *document) issueLine(issue hangulize.Issue) int {
+	sec, ok := d.hsl[issue.Section]
+	if !ok {
+		return 0
+	}
+
+	if issue.Rule != nil {
+		return sec.Pairs()[issue.Rule.ID].Line() - 1
+	}
+
+	for _, pair := range sec.Pairs() {
+		if pair.Left() == issue.Var {
+			return pair.Line() - 1
+		}
+	}
+
+	return sec.Line() - 1
+}
+
+// dict returns a dict section as a map.
+func (d *document) dict(name string) map[string][]string {
+	if sec, ok := d.hsl[name].(*hsl.DictSection); ok {
+		return sec.Map()
+	}
+	return nil
+}
+
+// macros returns the "macros" section as a map.
+func (d *document) macros() map[string]string {
+	macros := make(map[string]string)
+	for src, dsts := range d.dict("macros") {
+		if len(dsts) != 0 {
+			macros[src] = dsts[0]
+		}
+	}
+	return macros
+}
+
+// pairAt finds the pair defined at the line.
+func (d *document) pairAt(line int) (string, int, bool) {
+	for name, sec := range d.hsl {
+		for i, pair := range sec.Pairs() {
+			if pair.Line()-1 == line {
+				return name, i, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// lineAt returns the text of the line. It returns an empty string if the line
+// is out of the document.
+func (d *document) lineAt(line int) string {
+	if line < 0 || line >= len(d.lines) {
+		return ""
+	}
+	return d.lines[line]
+}
+
+var reVarRef = regexp.MustCompile(`<(.+?)>`)
+
+// varAt finds a var reference such as "<vowels>" at the position.
+func (d *document) varAt(pos position) (string, textRange, bool) {
+	text := d.lineAt(pos.Line)
+	col := byteOffset(text, pos.Character)
+
+	for _, m := range reVarRef.FindAllStringSubmatchIndex(text, -1) {
+		if m[0] <= col && col < m[1] {
+			r := lineRange(pos.Line, text, m[0], m[1])
+			return text[m[2]:m[3]], r, true
+		}
+	}
+
+	return "", textRange{}, false
+}
+
+// macroAt finds a macro such as "@" in a rule at the position.
+func (d *document) macroAt(pos position) (string, textRange, bool) {
+	name, _, ok := d.pairAt(pos.Line)
+	if !ok || (name != "rewrite" && name != "transcribe") {
+		return "", textRange{}, false
+	}
+
+	text := d.lineAt(pos.Line)
+	col := byteOffset(text, pos.Character)
+
+	for src := range d.macros() {
+		offset := 0
+		for {
+			i := strings.Index(text[offset:], src)
+			if i == -1 {
+				break
+			}
+
+			start := offset + i
+			stop := start + len(src)
+			if start <= col && col < stop {
+				return src, lineRange(pos.Line, text, start, stop), true
+			}
+			offset = stop
+		}
+	}
+
+	return "", textRange{}, false
+}
+
+// definition finds the location where the var or macro at the position is
+// defined.
+func (d *document) definition(pos position) *location {
+	if d.hsl == nil {
+		return nil
+	}
+
+	var name, section string
+
+	if v, _, ok := d.varAt(pos); ok {
+		name, section = v, "vars"
+	} else if m, _, ok := d.macroAt(pos); ok {
+		name, section = m, "macros"
+	} else {
+		return nil
+	}
+
+	sec, ok := d.hsl[section]
+	if !ok {
+		return nil
+	}
+
+	for _, pair := range sec.Pairs() {
+		if pair.Left() != name {
+			continue
+		}
+
+		line := pair.Line() - 1
+		text := d.lineAt(line)
+
+		start := strings.Index(text, name)
+		if start == -1 {
+			start = 0
+		}
+
+		return &location{d.uri, lineRange(line, text, start, start+len(name))}
+	}
+
+	return nil
+}
+
+// hover describes the var, the macro, the rule, or the test example at the
+// position.
+func (d *document) hover(pos position) *hover {
+	if d.hsl == nil {
+		return nil
+	}
+
+	var buf strings.Builder
+	var r *textRange
+
+	if name, vr, ok := d.varAt(pos); ok {
+		vals, defined := d.dict("vars")[name]
+		if !defined {
+			fmt.Fprintf(&buf, "`<%s>` is not defined", name)
+		} else {
+			fmt.Fprintf(&buf, "`<%s>` = %s", name, quoteAll(vals))
+		}
+		r = &vr
+	} else if src, mr, ok := d.macroAt(pos); ok {
+		fmt.Fprintf(&buf, "macro `%s` → `%s`", src, d.macros()[src])
+		r = &mr
+	} else if !d.describePair(&buf, pos.Line) {
+		return nil
+	}
+
+	return &hover{markupContent{"markdown", buf.String()}, r}
+}
+
+// describePair writes a description of the rule or the test example at the
+// line.
+func (d *document) describePair(buf *strings.Builder, line int) bool {
+	if d.spec == nil {
+		return false
+	}
+
+	name, i, ok := d.pairAt(line)
+	if !ok {
+		return false
+	}
+
+	var rule hangulize.Rule
+
+	switch name {
+	case "rewrite":
+		rule = d.spec.Rewrite[i]
+	case "transcribe":
+		rule = d.spec.Transcribe[i]
+	case "test":
+		if i >= len(d.results) {
+			return false
+		}
+		exm := d.spec.Test[i]
+		fmt.Fprintf(buf, "`%s` → `%s`", exm[0], d.results[i])
+		if d.results[i] == exm[1] {
+			buf.WriteString(" (passed)")
+		} else {
+			buf.WriteString(" (failed)")
+		}
+		return true
+	default:
+		return false
+	}
+
+	// Comments just above the rule document the rule.
+	var comments []string
+	for l := line - 1; l >= 0; l-- {
+		text := strings.TrimSpace(d.lineAt(l))
+		if !strings.HasPrefix(text, "#") {
+			break
+		}
+		comment := strings.TrimSpace(strings.TrimPrefix(text, "#"))
+		comments = append([]string{comment}, comments...)
+	}
+	if len(comments) != 0 {
+		buf.WriteString(strings.Join(comments, "\n"))
+		buf.WriteString("\n\n")
+	}
+
+	fmt.Fprintf(buf, "%s rule #%d\n\n", name, rule.ID)
+	fmt.Fprintf(buf, "```\n%s\n```\n\n", rule)
+
+	vars := d.dict("vars")
+	for _, v := range rule.From.Vars() {
+		if vals, ok := vars[v]; ok {
+			fmt.Fprintf(buf, "- `<%s>` = %s\n", v, quoteAll(vals))
+		}
+	}
+	fmt.Fprintf(buf, "\n`%s`", rule.From.Explain())
+
+	return true
+}
+
+// -----------------------------------------------------------------------------
+// Position helpers. LSP counts characters in UTF-16 code units.
+
+// utf16Len returns the length of the string in UTF-16 code units.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += len(utf16.Encode([]rune{r}))
+	}
+	return n
+}
+
+// byteOffset converts a UTF-16 column into a byte offset in the string.
+func byteOffset(s string, col int) int {
+	n := 0
+	for i, r := range s {
+		if n >= col {
+			return i
+		}
+		n += len(utf16.Encode([]rune{r}))
+	}
+	return len(s)
+}
+
+// lineRange creates a range in a line from byte offsets.
+func lineRange(line int, text string, start, stop int) textRange {
+	return textRange{
+		position{line, utf16Len(text[:start])},
+		position{line, utf16Len(text[:stop])},
+	}
+}
+
+// quoteAll quotes strings like values in HSL.
+func quoteAll(vals []string) string {
+	quoted := make([]string, len(vals))
+	for i, val := range vals {
+		quoted[i] = `"` + val + `"`
+	}
+	return strings.Join(quoted, ", ")
+}
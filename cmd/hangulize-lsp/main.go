@@ -0,0 +1,23 @@
+/*
+Command hangulize-lsp is a language server for HSL spec files. It speaks the
+Language Server Protocol over stdin and stdout so that any editor with an LSP
+client can use it:
+
+  - Diagnostics for parse errors, lint issues, and failing test examples.
+  - Hover on a var, a macro, a rule, or a test example.
+  - Go to the definition of a var or a macro.
+*/
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	s := newServer(os.Stdin, os.Stdout)
+	if err := s.serve(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
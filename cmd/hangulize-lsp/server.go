@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// server is a language server for HSL files. It serves one client over a
+// pair of streams.
+type server struct {
+	r *bufio.Reader
+	w io.Writer
+
+	docs map[string]*document
+}
+
+// newServer creates a server which reads requests from r and writes responses
+// to w.
+func newServer(r io.Reader, w io.Writer) *server {
+	return &server{bufio.NewReader(r), w, make(map[string]*document)}
+}
+
+// serve handles messages until the client sends "exit" or closes the stream.
+func (s *server) serve() error {
+	for {
+		msg, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches a message to the handler for its method.
+func (s *server) handle(msg *message) error {
+	var (
+		result interface{}
+		rerr   *responseError
+	)
+
+	switch msg.Method {
+
+	case "initialize":
+		result = map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":   1, // full
+				"hoverProvider":      true,
+				"definitionProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "hangulize-lsp"},
+		}
+
+	case "shutdown":
+		result = nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		return s.open(params.TextDocument.URI, params.TextDocument.Text)
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil
+		}
+		last := params.ContentChanges[len(params.ContentChanges)-1]
+		return s.open(params.TextDocument.URI, last.Text)
+
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil
+
+	case "textDocument/hover", "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			rerr = &responseError{codeInvalidParams, err.Error()}
+			break
+		}
+
+		doc, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			break
+		}
+
+		if msg.Method == "textDocument/hover" {
+			if h := doc.hover(params.Position); h != nil {
+				result = h
+			}
+		} else {
+			if loc := doc.definition(params.Position); loc != nil {
+				result = loc
+			}
+		}
+
+	default:
+		if msg.ID == nil {
+			// Ignore unknown notifications.
+			return nil
+		}
+		rerr = &responseError{codeMethodNotFound, "method not found: " + msg.Method}
+	}
+
+	if msg.ID == nil {
+		return nil
+	}
+
+	resp := response{JSONRPC: "2.0", ID: msg.ID, Error: rerr}
+	if rerr == nil {
+		// "result" is required on success even if it is null.
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.Result = b
+	}
+	return s.write(resp)
+}
+
+// open analyzes a document and publishes its diagnostics.
+func (s *server) open(uri, text string) error {
+	doc := newDocument(uri, text)
+	s.docs[uri] = doc
+
+	params := publishDiagnosticsParams{uri, doc.diagnostics}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return s.write(message{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params:  b,
+	})
+}
+
+// read reads a message framed by the "Content-Length" header.
+func (s *server) read() (*message, error) {
+	tp := textproto.NewReader(s.r)
+
+	header, err := tp.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get("Content-Length")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Length: %w", err)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.r, body); err != nil {
+		return nil, err
+	}
+
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// write writes a message framed by the "Content-Length" header.
+func (s *server) write(msg interface{}) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.w, "Content-Length: %d\r\n\r\n%s", len(b), b)
+	return err
+}
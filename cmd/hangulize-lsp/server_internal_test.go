@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHSL = `vars:
+    "vowels" = "a", "e", "i", "o", "u"
+
+macros:
+    "@" = "<vowels>"
+
+rewrite:
+    # Double consonants.
+    "cc{@}" -> "c"
+    "<x>b" -> "y"
+
+transcribe:
+    "c" -> "ㅋ"
+    "a" -> "ㅏ"
+    "o" -> "ㅗ"
+
+test:
+    "cacao" -> "카카오"
+    "coca"  -> "카카"
+`
+
+func TestDocumentDiagnostics(t *testing.T) {
+	d := newDocument("file:///test.hsl", testHSL)
+	require.Len(t, d.diagnostics, 2)
+
+	// Undefined var in a rule.
+	assert.Equal(t, 9, d.diagnostics[0].Range.Start.Line)
+	assert.Equal(t, severityWarning, d.diagnostics[0].Severity)
+
+	// Failed test example.
+	assert.Equal(t, 18, d.diagnostics[1].Range.Start.Line)
+	assert.Contains(t, d.diagnostics[1].Message, `expected: "카카"`)
+}
+
+func TestDocumentParseError(t *testing.T) {
+	d := newDocument("file:///test.hsl", "rewrite:\n    \"a\" -> \"b")
+	require.Len(t, d.diagnostics, 1)
+	assert.Equal(t, severityError, d.diagnostics[0].Severity)
+	assert.Equal(t, 1, d.diagnostics[0].Range.Start.Line)
+}
+
+func TestDocumentDefinition(t *testing.T) {
+	d := newDocument("file:///test.hsl", testHSL)
+
+	// "@" in "cc{@}"
+	loc := d.definition(position{8, 8})
+	require.NotNil(t, loc)
+	assert.Equal(t, 4, loc.Range.Start.Line)
+
+	// "<vowels>" in the macro
+	loc = d.definition(position{4, 12})
+	require.NotNil(t, loc)
+	assert.Equal(t, 1, loc.Range.Start.Line)
+
+	assert.Nil(t, d.definition(position{0, 0}))
+}
+
+func TestDocumentHover(t *testing.T) {
+	d := newDocument("file:///test.hsl", testHSL)
+
+	h := d.hover(position{8, 8})
+	require.NotNil(t, h)
+	assert.Equal(t, "macro `@` → `<vowels>`", h.Contents.Value)
+
+	h = d.hover(position{8, 0})
+	require.NotNil(t, h)
+	assert.Contains(t, h.Contents.Value, "Double consonants.")
+	assert.Contains(t, h.Contents.Value, "rewrite rule #0")
+
+	h = d.hover(position{17, 6})
+	require.NotNil(t, h)
+	assert.Equal(t, "`cacao` → `카카오` (passed)", h.Contents.Value)
+}
+
+func frame(body string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func TestServe(t *testing.T) {
+	open, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  "textDocument/didOpen",
+		"params": map[string]interface{}{
+			"textDocument": map[string]string{"uri": "file:///test.hsl", "text": testHSL},
+		},
+	})
+
+	in := strings.Join([]string{
+		frame(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`),
+		frame(string(open)),
+		frame(`{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///test.hsl"},"position":{"line":0,"character":0}}}`),
+		frame(`{"jsonrpc":"2.0","id":3,"method":"unknown"}`),
+		frame(`{"jsonrpc":"2.0","method":"exit"}`),
+	}, "")
+
+	var out bytes.Buffer
+	s := newServer(strings.NewReader(in), &out)
+	require.NoError(t, s.serve())
+
+	rendered := out.String()
+	assert.Contains(t, rendered, `"hoverProvider":true`)
+	assert.Contains(t, rendered, `"method":"textDocument/publishDiagnostics"`)
+	assert.Contains(t, rendered, `{"jsonrpc":"2.0","id":2,"result":null}`)
+	assert.Contains(t, rendered, `"code":-32601`)
+}
@@ -6,11 +6,16 @@ import (
 	"syscall/js"
 
 	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/romaji"
 )
 
 var version string
 
 func main() {
+	// Romaji is light enough to be built in. The other Translits are
+	// provided by JavaScript.
+	hangulize.UseTranslit(romaji.T)
+
 	js.Global().Set("hangulize", jsHangulize)
 	js.Global().Get("hangulize").Set("version", version)
 	js.Global().Get("hangulize").Set("specs", jsSpecs(hangulize.ListLangs()))
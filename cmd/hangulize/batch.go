@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/corpus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	parallel int
+	resume   string
+)
+
+func init() {
+	rootCmd.Flags().IntVarP(
+		&parallel, "parallel", "p", 0,
+		"Hangulize the lines from stdin in N goroutines. "+
+			"The output is in the order of the input.",
+	)
+	rootCmd.Flags().StringVarP(
+		&resume, "resume", "", "",
+		"Record the progress of the lines from stdin in a state file "+
+			"and skip the processed lines on restart.",
+	)
+}
+
+// hangulizeBatch hangulizes the lines from stdin concurrently and reports the
+// throughput at the end. The progress is saved in the state file when it is
+// interrupted.
+func hangulizeBatch(cmd *cobra.Command, h hangulize.Hangulizer) {
+	if verbose {
+		cmd.PrintErrln("--verbose is not supported with --parallel or --resume")
+		os.Exit(1)
+	}
+
+	// The lines processed before resuming are excluded from the throughput.
+	var resumed corpus.State
+	if resume != "" {
+		var err error
+		if resumed, err = corpus.ReadState(resume); err != nil {
+			cmd.PrintErrln(err)
+			os.Exit(1)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	p, err := corpus.Process(cmd.InOrStdin(), corpus.Options{
+		Hangulizer: h,
+		Output:     cmd.OutOrStdout(),
+		StateFile:  resume,
+		Workers:    parallel,
+		Context:    ctx,
+		OnError: func(line int, text string, err error) error {
+			cmd.PrintErrf("line %d: %s: %s\n", line, text, err)
+			return nil
+		},
+	})
+	if err != nil {
+		if resume != "" {
+			cmd.PrintErrf("%s (%d lines saved in %s)\n", err, p.Lines, resume)
+		} else {
+			cmd.PrintErrln(err)
+		}
+		os.Exit(1)
+	}
+
+	cmd.PrintErrf(
+		"%d lines (%d errors) in %s: %.0f lines/s\n",
+		p.Lines, p.Errors, p.Elapsed.Round(time.Millisecond),
+		float64(p.Lines-resumed.Lines)/p.Elapsed.Seconds(),
+	)
+}
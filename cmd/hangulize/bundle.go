@@ -0,0 +1,110 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"os"
+	"strings"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bundleTrust         []string
+	bundleAllowUnsigned bool
+)
+
+func init() {
+	bundleVerifyCmd.Flags().StringArrayVarP(
+		&bundleTrust, "trust", "", nil,
+		"Public key file of a trusted signer.",
+	)
+	bundleVerifyCmd.Flags().BoolVarP(
+		&bundleAllowUnsigned, "allow-unsigned", "", false,
+		"Accept bundles without signature. Signed bundles are still checked.",
+	)
+
+	bundleCmd.AddCommand(bundlePackCmd)
+	bundleCmd.AddCommand(bundleVerifyCmd)
+	rootCmd.AddCommand(bundleCmd)
+}
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Pack and verify spec bundles",
+}
+
+var bundlePackCmd = &cobra.Command{
+	Use:   "pack HSL [HSL...]",
+	Short: "Pack HSL files into bundles next to them",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range args {
+			file, err := os.Open(name)
+			if err != nil {
+				return err
+			}
+
+			spec, err := hangulize.ParseSpec(file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+
+			out, err := os.Create(strings.TrimSuffix(name, ".hsl") + hangulize.BundleExt)
+			if err != nil {
+				return err
+			}
+
+			b := &hangulize.Bundle{Spec: spec, Translits: spec.Lang.Translit}
+			if err := hangulize.WriteBundle(out, b); err != nil {
+				out.Close()
+				return err
+			}
+			if err := out.Close(); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var bundleVerifyCmd = &cobra.Command{
+	Use:   "verify HGB [HGB...]",
+	Short: "Verify bundles with the standard Translits",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		trust := hangulize.Trust{AllowUnsigned: bundleAllowUnsigned}
+		for _, name := range bundleTrust {
+			key, err := readKey(name, ed25519.PublicKeySize)
+			if err != nil {
+				cmd.PrintErrln(err)
+				os.Exit(1)
+			}
+			trust.Keys = append(trust.Keys, ed25519.PublicKey(key))
+		}
+
+		translits := make(map[string]hangulize.Translit)
+		for _, t := range translit.Translits() {
+			translits[t.Scheme()] = t
+		}
+
+		failed := false
+		for _, name := range args {
+			// Unsigned bundles are refused unless --allow-unsigned.
+			b, err := hangulize.OpenSignedBundle(name, trust)
+			if err == nil {
+				err = b.Verify(translits)
+			}
+			if err != nil {
+				cmd.PrintErrf("%s: %s\n", name, err)
+				failed = true
+			}
+		}
+
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
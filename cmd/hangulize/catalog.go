@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/catalog"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/spf13/cobra"
+)
+
+var catalogTag string
+
+func init() {
+	catalogCmd.Flags().StringVarP(
+		&catalogTag, "tag", "t", catalog.DefaultTag,
+		"Tag which marks the messages to transcribe.",
+	)
+
+	rootCmd.AddCommand(catalogCmd)
+}
+
+var catalogCmd = &cobra.Command{
+	Use:   "catalog LANG FILE [FILE...]",
+	Short: "Transcribe the tagged messages in go-i18n, gotext or PO catalogs",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spec, err := hangulize.LoadSpec(args[0])
+		if err != nil {
+			return err
+		}
+
+		h := hangulize.New(spec)
+		translit.Install(h)
+
+		opts := catalog.Options{Tag: catalogTag}
+
+		for _, name := range args[1:] {
+			n, err := catalog.TranscribeFile(h, name, opts)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("%s: %d messages transcribed\n", name, n)
+		}
+
+		return nil
+	},
+}
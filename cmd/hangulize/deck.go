@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/spf13/cobra"
+)
+
+var (
+	deckLang string
+	deckAnki bool
+)
+
+func init() {
+	deckCmd.Flags().StringVarP(
+		&deckLang, "lang", "l", "",
+		"Language of the words.",
+	)
+	deckCmd.Flags().BoolVarP(
+		&deckAnki, "anki", "", false,
+		"Write the file headers for Anki instead of the header row.",
+	)
+	_ = deckCmd.MarkFlagRequired("lang")
+
+	rootCmd.AddCommand(deckCmd)
+}
+
+var deckCmd = &cobra.Command{
+	Use:   "deck --lang LANG [FILE...]",
+	Short: "Export words as flashcards in CSV",
+	Long: `Export words as flashcards in CSV with the columns:
+
+  word        the source word
+  hangul      the transcription
+  candidates  the different transcriptions by the other specs of the language
+  ipa         the second column of the input separated by a tab, if any
+
+The words are read from the files line by line, or from stdin if no file is
+given. With --anki, the file can be imported into Anki directly.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		h, err := newDeckHangulizer(deckLang)
+		if err != nil {
+			return err
+		}
+		alts, err := siblingHangulizers(deckLang)
+		if err != nil {
+			return err
+		}
+
+		w := csv.NewWriter(cmd.OutOrStdout())
+		if deckAnki {
+			cmd.Print("#separator:Comma\n#html:false\n#columns:word,hangul,candidates,ipa\n")
+		} else {
+			_ = w.Write([]string{"word", "hangul", "candidates", "ipa"})
+		}
+
+		writeCards := func(r io.Reader) error {
+			scanner := bufio.NewScanner(r)
+			for scanner.Scan() {
+				word, ipa, _ := strings.Cut(scanner.Text(), "\t")
+				word = strings.TrimSpace(word)
+				if word == "" {
+					continue
+				}
+
+				result, err := h.Hangulize(word)
+				if err != nil {
+					cmd.PrintErrf("%s: %s\n", word, err)
+					continue
+				}
+
+				var candidates []string
+				for _, alt := range alts {
+					c, err := alt.Hangulize(word)
+					if err == nil && c != result && !contains(candidates, c) {
+						candidates = append(candidates, c)
+					}
+				}
+
+				err = w.Write([]string{word, result, strings.Join(candidates, " / "), strings.TrimSpace(ipa)})
+				if err != nil {
+					return err
+				}
+			}
+			return scanner.Err()
+		}
+
+		if len(args) == 0 {
+			if err := writeCards(cmd.InOrStdin()); err != nil {
+				return err
+			}
+		}
+		for _, name := range args {
+			file, err := os.Open(name)
+			if err != nil {
+				return err
+			}
+			err = writeCards(file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		}
+
+		w.Flush()
+		return w.Error()
+	},
+}
+
+// newDeckHangulizer creates a Hangulizer for a bundled spec with the
+// Translits installed.
+func newDeckHangulizer(lang string) (hangulize.Hangulizer, error) {
+	spec, err := hangulize.LoadSpec(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hangulize.New(spec)
+	translit.Install(h)
+	return h, nil
+}
+
+// siblingHangulizers creates Hangulizers for the other bundled specs of the
+// same language, such as "jpn-ck" for "jpn".
+func siblingHangulizers(lang string) ([]hangulize.Hangulizer, error) {
+	spec, err := hangulize.LoadSpec(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	var hs []hangulize.Hangulizer
+	for _, other := range hangulize.ListLangs() {
+		if other == lang {
+			continue
+		}
+
+		otherSpec, err := hangulize.LoadSpec(other)
+		if err != nil || otherSpec.Lang.Codes[1] != spec.Lang.Codes[1] {
+			continue
+		}
+
+		h, err := newDeckHangulizer(other)
+		if err != nil {
+			return nil, err
+		}
+		hs = append(hs, h)
+	}
+	return hs, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/hangulize/hangulize"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fmtWrite    bool
+	fmtList     bool
+	fmtSortVars bool
+)
+
+func init() {
+	fmtCmd.Flags().BoolVarP(
+		&fmtWrite, "write", "w", false,
+		"Write the result to the source file instead of stdout.",
+	)
+	fmtCmd.Flags().BoolVarP(
+		&fmtList, "list", "l", false,
+		"List files whose formatting differs from the canonical layout.",
+	)
+	fmtCmd.Flags().BoolVarP(
+		&fmtSortVars, "sort-vars", "", false,
+		"Sort the vars by their names.",
+	)
+
+	rootCmd.AddCommand(fmtCmd)
+}
+
+var fmtCmd = &cobra.Command{
+	Use:   "fmt HSL [HSL...]",
+	Short: "Format HSL files in the canonical layout",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := hangulize.FormatOptions{SortVars: fmtSortVars}
+
+		for _, name := range args {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+
+			res, err := hangulize.FormatSpec(src, opts)
+			if err != nil {
+				cmd.PrintErrf("%s: %s\n", name, err)
+				os.Exit(1)
+			}
+
+			if fmtList {
+				if !bytes.Equal(src, res) {
+					fmt.Fprintln(cmd.OutOrStdout(), name)
+				}
+				continue
+			}
+
+			if fmtWrite {
+				if bytes.Equal(src, res) {
+					continue
+				}
+				if err := os.WriteFile(name, res, 0644); err != nil {
+					return err
+				}
+				continue
+			}
+
+			cmd.OutOrStdout().Write(res)
+		}
+
+		return nil
+	},
+}
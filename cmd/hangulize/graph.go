@@ -0,0 +1,70 @@
+package main
+
+import (
+	"os"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/spf13/cobra"
+)
+
+var (
+	graphMermaid bool
+	graphCover   bool
+)
+
+func init() {
+	graphCmd.Flags().BoolVarP(
+		&graphMermaid, "mermaid", "", false,
+		"Write a Mermaid flowchart instead of a Graphviz graph.",
+	)
+	graphCmd.Flags().BoolVarP(
+		&graphCover, "cover", "", false,
+		"Annotate the rules with the hits by the test examples.",
+	)
+
+	rootCmd.AddCommand(graphCmd)
+}
+
+var graphCmd = &cobra.Command{
+	Use:   "graph HSL",
+	Short: "Visualize the structure of an HSL file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		spec, err := hangulize.ParseSpec(file)
+		if err != nil {
+			return err
+		}
+
+		var opts hangulize.GraphOptions
+		if graphMermaid {
+			opts.Format = hangulize.Mermaid
+		}
+
+		if graphCover {
+			var traces []hangulize.Trace
+
+			h := hangulize.New(spec)
+			translit.Install(h)
+			h.Trace(func(t hangulize.Trace) {
+				traces = append(traces, t)
+			})
+
+			for _, exm := range spec.Test {
+				if _, err := h.Hangulize(exm[0]); err != nil {
+					return err
+				}
+			}
+
+			opts.Stats = hangulize.CountRuleHits(traces)
+		}
+
+		return hangulize.WriteGraph(cmd.OutOrStdout(), spec, opts)
+	},
+}
@@ -41,6 +41,11 @@ var rootCmd = &cobra.Command{
 
 		h := hangulize.New(spec)
 		translit.Install(h)
+
+		if len(args) == 1 && (parallel > 0 || resume != "") {
+			hangulizeBatch(cmd, h)
+			return
+		}
 		hangulizeStream(cmd, args, h)
 	},
 }
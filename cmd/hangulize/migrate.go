@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+
+	"github.com/hangulize/hangulize"
+	"github.com/spf13/cobra"
+)
+
+var migrateWrite bool
+
+func init() {
+	migrateCmd.Flags().BoolVarP(
+		&migrateWrite, "write", "w", false,
+		"Write the result to the source file instead of stdout.",
+	)
+
+	rootCmd.AddCommand(migrateCmd)
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate HSL [HSL...]",
+	Short: "Upgrade HSL files in the legacy format",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range args {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+
+			res, changes, err := hangulize.MigrateSpec(src)
+			if err != nil {
+				cmd.PrintErrf("%s: %s\n", name, err)
+				os.Exit(1)
+			}
+
+			for _, change := range changes {
+				cmd.PrintErrf("%s: %s\n", name, change)
+			}
+
+			if migrateWrite {
+				if len(changes) == 0 {
+					continue
+				}
+				if err := os.WriteFile(name, res, 0644); err != nil {
+					return err
+				}
+				continue
+			}
+
+			cmd.OutOrStdout().Write(res)
+		}
+
+		return nil
+	},
+}
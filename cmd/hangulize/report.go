@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/spf13/cobra"
+)
+
+var reportLang string
+
+func init() {
+	reportCmd.Flags().StringVarP(
+		&reportLang, "lang", "l", "",
+		"Language of the word.",
+	)
+	_ = reportCmd.MarkFlagRequired("lang")
+
+	rootCmd.AddCommand(reportCmd)
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report --lang LANG WORD",
+	Short: "Generate a debug bundle for a bug report",
+	Long: `Generate a debug bundle for a bug report in JSON. The bundle contains the
+input, the result, the options, the versions of Hangulize, the spec and the
+datasets, the full traces and the environment so that the transcription can
+be reproduced.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spec, err := hangulize.LoadSpec(reportLang)
+		if err != nil {
+			return err
+		}
+
+		h := hangulize.New(spec)
+		translit.Install(h)
+
+		report := hangulize.NewReport(h, strings.Join(args, " "))
+
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(report)
+	},
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/hangulize/hangulize"
+	"github.com/spf13/cobra"
+)
+
+var signKey string
+
+func init() {
+	signCmd.Flags().StringVarP(
+		&signKey, "key", "k", "",
+		"Private key file made by keygen.",
+	)
+	signCmd.MarkFlagRequired("key")
+
+	rootCmd.AddCommand(keygenCmd)
+	rootCmd.AddCommand(signCmd)
+}
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen NAME",
+	Short: "Generate an ed25519 key pair into NAME and NAME.pub",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			return err
+		}
+
+		name := args[0]
+		if err := writeKey(name, priv, 0600); err != nil {
+			return err
+		}
+		return writeKey(name+".pub", pub, 0644)
+	},
+}
+
+var signCmd = &cobra.Command{
+	Use:   "sign --key KEY FILE [FILE...]",
+	Short: "Sign HSL or HGB files into detached signatures",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, err := readKey(signKey, ed25519.PrivateKeySize)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range args {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+
+			sig := hangulize.Sign(src, ed25519.PrivateKey(key))
+			if err := os.WriteFile(name+hangulize.SignatureExt, sig, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func writeKey(name string, key []byte, perm os.FileMode) error {
+	encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+	return os.WriteFile(name, []byte(encoded), perm)
+}
+
+func readKey(name string, size int) ([]byte, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(key) != size {
+		return nil, fmt.Errorf("%s: malformed key", name)
+	}
+	return key, nil
+}
@@ -9,6 +9,7 @@ import (
 
 var testCover bool
 var testCoverProfile string
+var testWhy bool
 
 func init() {
 	testCmd.Flags().BoolVarP(
@@ -20,6 +21,11 @@ func init() {
 		"Write a coverage profile to the file after all tests have passed.",
 	)
 
+	testCmd.Flags().BoolVarP(
+		&testWhy, "why", "", false,
+		"Explain which rule caused each failure.",
+	)
+
 	rootCmd.AddCommand(testCmd)
 }
 
@@ -98,6 +104,15 @@ var testCmd = &cobra.Command{
 				cmd.Printf(`, expected: "%s"`, expected)
 				cmd.Println()
 				failedAtLeastOnce = true
+
+				if testWhy {
+					why, err := hangulize.ExplainWhyNot(h, word, expected)
+					if err != nil {
+						cmd.PrintErrln(err)
+						continue
+					}
+					cmd.Println(why)
+				}
 			}
 		}
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/hangulize/hangulize"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +15,9 @@ var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version of Hangulize",
 	Run: func(cmd *cobra.Command, args []string) {
+		if version == "" {
+			version = hangulize.Version()
+		}
 		cmd.Printf("hangulize %s", version)
 		cmd.Println()
 	},
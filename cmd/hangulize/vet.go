@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/hangulize/hangulize"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vetBench     bool
+	vetBenchTime time.Duration
+)
+
+func init() {
+	vetCmd.Flags().BoolVarP(
+		&vetBench, "bench", "", false,
+		"Benchmark each rule and report pathological patterns.",
+	)
+	vetCmd.Flags().DurationVarP(
+		&vetBenchTime, "benchtime", "", 10*time.Millisecond,
+		"Time to run each rule for the benchmark.",
+	)
+
+	rootCmd.AddCommand(vetCmd)
+}
+
+var vetCmd = &cobra.Command{
+	Use:   "vet HSL [HSL...]",
+	Short: "Report suspicious definitions and conflicting rules in HSL files",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		foundAtLeastOnce := false
+
+		for _, name := range args {
+			file, err := os.Open(name)
+			if err != nil {
+				return err
+			}
+
+			spec, err := hangulize.ParseSpec(file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+
+			for _, issue := range hangulize.Lint(spec) {
+				cmd.Printf("%s: %s\n", name, issue)
+				foundAtLeastOnce = true
+			}
+
+			for _, conflict := range hangulize.FindConflicts(spec) {
+				cmd.Printf("%s: %s\n", name, conflict)
+				foundAtLeastOnce = true
+			}
+
+			if !vetBench {
+				continue
+			}
+			for _, bench := range hangulize.BenchmarkRules(spec, vetBenchTime) {
+				if len(bench.Warnings) != 0 {
+					cmd.Printf("%s: %s\n", name, bench)
+					foundAtLeastOnce = true
+				} else if verbose {
+					cmd.Printf("%s: %s\n", name, bench)
+				}
+			}
+		}
+
+		// Exit with 1 if found at least once.
+		if foundAtLeastOnce {
+			os.Exit(1)
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,257 @@
+package hangulize
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Conflict is a pair of rules which interfere with each other. The earlier
+// rule always wins because rules are applied in order.
+type Conflict struct {
+	// Section is "rewrite" or "transcribe".
+	Section string
+
+	// Rule is the later rule which is disturbed by the earlier rule.
+	Rule *Rule
+
+	// By is the earlier rule.
+	By *Rule
+
+	// Shadowed is true if Rule can never fire because of By. Otherwise, the
+	// patterns of the rules overlap ambiguously so that the result depends on
+	// the order of the rules.
+	Shadowed bool
+
+	// Example is an input which demonstrates the conflict.
+	Example string
+}
+
+func (c Conflict) String() string {
+	if c.Shadowed {
+		return fmt.Sprintf(
+			"%s: %s is shadowed by %s, e.g., %#v",
+			c.Section, c.Rule, c.By, c.Example,
+		)
+	}
+	return fmt.Sprintf(
+		"%s: %s overlaps with %s, e.g., %#v",
+		c.Section, c.Rule, c.By, c.Example,
+	)
+}
+
+// conflictExamples is the number of example inputs generated from each rule
+// to find conflicts.
+const conflictExamples = 8
+
+// FindConflicts finds rules which can never fire because an earlier rule
+// always rewrites or transcribes their match first, and rules in the
+// "transcribe" section whose patterns overlap ambiguously.
+//
+// The analysis is based on example inputs generated from the patterns. So it
+// may miss some conflicts, but every reported conflict comes with an example
+// input which actually demonstrates it.
+func FindConflicts(spec *Spec) []Conflict {
+	var conflicts []Conflict
+
+	rewrite := examplesOf(spec.Rewrite, contextLetters(spec.Rewrite))
+	for j := range spec.Rewrite {
+		if c, ok := findShadow(spec.Rewrite, rewrite, j, rewriteShadows); ok {
+			c.Section = "rewrite"
+			conflicts = append(conflicts, c)
+		}
+	}
+
+	transcribe := examplesOf(spec.Transcribe, contextLetters(spec.Transcribe))
+	shadowed := make(map[int]bool)
+	for j := range spec.Transcribe {
+		if c, ok := findShadow(spec.Transcribe, transcribe, j, transcribeShadows); ok {
+			c.Section = "transcribe"
+			conflicts = append(conflicts, c)
+			shadowed[j] = true
+			continue
+		}
+
+		for i := 0; i < j; i++ {
+			// A shadowed rule never fires so that it can't conflict.
+			if shadowed[i] {
+				continue
+			}
+
+			a, b := &spec.Transcribe[i], &spec.Transcribe[j]
+			if example, ok := findOverlap(a, b, transcribe[i], transcribe[j]); ok {
+				conflicts = append(conflicts, Conflict{"transcribe", b, a, false, example})
+				break
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// examplesOf generates the example inputs of each rule. An example is also
+// surrounded by the context letters to avoid a false shadowing only at the
+// edges.
+func examplesOf(rules []Rule, contexts []string) [][]string {
+	examples := make([][]string, len(rules))
+
+	for i, rule := range rules {
+		for _, word := range rule.From.Examples(conflictExamples) {
+			examples[i] = append(examples[i], word)
+
+			for _, c := range contexts {
+				for _, w := range []string{c + word, word + c, c + word + c} {
+					if len(rule.From.Find(w, 1)) != 0 {
+						examples[i] = append(examples[i], w)
+					}
+				}
+			}
+		}
+	}
+
+	return examples
+}
+
+// contextLetters chooses a few letters used in the rules. They are the
+// first, the middle, and the last one in the order of code points.
+func contextLetters(rules []Rule) []string {
+	set := make(map[rune]bool)
+	for _, rule := range rules {
+		for _, let := range rule.From.Letters() {
+			if unicode.IsLetter(let) {
+				set[let] = true
+			}
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	letters := make([]rune, 0, len(set))
+	for let := range set {
+		letters = append(letters, let)
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i] < letters[j]
+	})
+
+	n := len(letters)
+	contexts := []string{string(letters[0])}
+	if n > 2 {
+		contexts = append(contexts, string(letters[n/2]))
+	}
+	if n > 1 {
+		contexts = append(contexts, string(letters[n-1]))
+	}
+	return contexts
+}
+
+// findShadow finds the first earlier rule which shadows the j-th rule for
+// every example input of the j-th rule.
+func findShadow(
+	rules []Rule,
+	examples [][]string,
+	j int,
+	shadows func(a, b *Rule, word string) bool,
+) (Conflict, bool) {
+	b := &rules[j]
+	if len(examples[j]) == 0 {
+		return Conflict{}, false
+	}
+
+	for i := 0; i < j; i++ {
+		a := &rules[i]
+
+		shadowed := true
+		for _, word := range examples[j] {
+			if !shadows(a, b, word) {
+				shadowed = false
+				break
+			}
+		}
+
+		if shadowed {
+			return Conflict{"", b, a, true, examples[j][0]}, true
+		}
+	}
+
+	return Conflict{}, false
+}
+
+// rewriteShadows reports whether b doesn't match anymore after a rewrites
+// the word.
+func rewriteShadows(a, b *Rule, word string) bool {
+	rewritten := a.Replace(word)
+	return rewritten != word && len(b.From.Find(rewritten, 1)) == 0
+}
+
+// transcribeShadows reports whether b doesn't match anymore after a
+// transcribes the word. The transcribed parts are masked with NULL characters
+// as the procedure does.
+func transcribeShadows(a, b *Rule, word string) bool {
+	repls := a.replacements(word)
+	if len(repls) == 0 {
+		return false
+	}
+
+	masked := []byte(word)
+	for _, repl := range repls {
+		for i := repl.Start; i < repl.Stop; i++ {
+			masked[i] = 0
+		}
+	}
+	return len(b.From.Find(string(masked), 1)) == 0
+}
+
+// findOverlap finds an input where the matches of a and b overlap partially.
+// The input is made by joining an example of a and an example of b, sharing
+// a common substring at the joint.
+func findOverlap(a, b *Rule, aExamples, bExamples []string) (string, bool) {
+	for _, x := range aExamples {
+		for _, y := range bExamples {
+			for _, word := range []string{joinOverlapped(x, y), joinOverlapped(y, x)} {
+				if word != "" && overlapsPartially(a, b, word) {
+					return word, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// joinOverlapped joins two strings with the longest proper suffix of x which
+// is also a prefix of y. It returns an empty string if there's no such
+// suffix.
+func joinOverlapped(x, y string) string {
+	for i := 1; i < len(x); i++ {
+		if !utf8.RuneStart(x[i]) {
+			continue
+		}
+		suffix := x[i:]
+		if len(suffix) < len(y) && strings.HasPrefix(y, suffix) {
+			return x + y[len(suffix):]
+		}
+	}
+	return ""
+}
+
+// overlapsPartially reports whether a match of a and a match of b in the word
+// overlap but neither of them contains the other.
+func overlapsPartially(a, b *Rule, word string) bool {
+	for _, ma := range a.From.Find(word, -1) {
+		for _, mb := range b.From.Find(word, -1) {
+			aStart, aStop := ma[0], ma[1]
+			bStart, bStop := mb[0], mb[1]
+
+			if aStart < bStart && bStart < aStop && aStop < bStop {
+				return true
+			}
+			if bStart < aStart && aStart < bStop && bStop < aStop {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindConflicts(t *testing.T) {
+	spec := mustParseSpec(`
+	vars:
+		"vowels" = "a", "e", "i", "o", "u"
+
+	rewrite:
+		"c"          -> "k"
+		"c{<vowels>}" -> "s"
+		"tt"         -> "t"
+		"t$"         -> "d"
+
+	transcribe:
+		"k"  -> "ㅋ"
+		"ka" -> "카"
+		"ab" -> "ㅏㅂ"
+		"bc" -> "ㅂㅊ"
+		"a"  -> "ㅏ"
+	`)
+
+	conflicts := hangulize.FindConflicts(spec)
+	require.Len(t, conflicts, 3)
+
+	// "c" has been rewritten to "k" already.
+	assert.Equal(t, "rewrite", conflicts[0].Section)
+	assert.Equal(t, 1, conflicts[0].Rule.ID)
+	assert.Equal(t, 0, conflicts[0].By.ID)
+	assert.True(t, conflicts[0].Shadowed)
+	assert.Equal(t, "ca", conflicts[0].Example)
+
+	// "k" has been transcribed before "ka".
+	assert.Equal(t, "transcribe", conflicts[1].Section)
+	assert.Equal(t, 1, conflicts[1].Rule.ID)
+	assert.Equal(t, 0, conflicts[1].By.ID)
+	assert.True(t, conflicts[1].Shadowed)
+
+	// "ab" and "bc" overlap in "abc".
+	assert.Equal(t, "transcribe", conflicts[2].Section)
+	assert.Equal(t, 3, conflicts[2].Rule.ID)
+	assert.Equal(t, 2, conflicts[2].By.ID)
+	assert.False(t, conflicts[2].Shadowed)
+	assert.Equal(t, "abc", conflicts[2].Example)
+	assert.Equal(t,
+		`transcribe: "bc" -> "ㅂㅊ" overlaps with "ab" -> "ㅏㅂ", e.g., "abc"`,
+		conflicts[2].String(),
+	)
+}
@@ -121,6 +121,13 @@ won't become as the input for the next rules:
 	    "f" -> "ㅍ"
 	    "g" -> "ㄱ"
 
+Well-known multi-word names may sound awkward when they are transcribed word
+by word. "phrases" defines their results. A phrase is matched
+case-insensitively at word boundaries before any other step:
+
+	phrases:
+	    "Città del Vaticano" = "바티칸시국"
+
 Finally, we should write expected transcription examples. They are used for
 unit testing. Verify your spec yourself:
 
@@ -0,0 +1,78 @@
+package hangulize
+
+import (
+	"strings"
+
+	"github.com/hangulize/hangulize/pkg/subword"
+)
+
+// EpentheticVowels overrides the vowel inserted after a consonant which has
+// no vowel in the transcription. It is "ㅡ" by default, such as "흐" in
+// "바흐" for "Bach".
+//
+// The keys are the consonant Jamo, such as 'ㅎ', and the key 0 applies to the
+// other consonants:
+//
+//	// "Bach" -> "바후", "Martin" -> "마루틴"
+//	hangulize.EpentheticVowels(map[rune]rune{0: 'ㅜ'})
+//
+// The vowels written in the spec explicitly, such as "ㅣ" after palatals in
+// some specs, are not affected.
+func EpentheticVowels(vowels map[rune]rune) Option {
+	return func(o *options) {
+		o.epentheticVowels = vowels
+	}
+}
+
+// epenthesize inserts the epenthetic vowels after the leading consonants
+// without a vowel in decomposed Jamo phonemes. If origins is not nil, it
+// returns the origins of the result as well. An inserted vowel originates
+// from the consonant.
+func (p procedure) epenthesize(word string, origins []subword.Span) (string, []subword.Span) {
+	if p.epentheticVowels == nil {
+		return word, origins
+	}
+
+	var buf strings.Builder
+	var newOrigins []subword.Span
+
+	chars := []rune(word)
+	offset := 0
+
+	for i, ch := range chars {
+		buf.WriteRune(ch)
+		size := len(string(ch))
+		if origins != nil {
+			newOrigins = append(newOrigins, origins[offset:offset+size]...)
+		}
+
+		isLead := isJaeum(ch) && (i == 0 || chars[i-1] != '-')
+		if isLead && (i+1 == len(chars) || !isMoeum(chars[i+1])) {
+			vowel, ok := p.epentheticVowels[ch]
+			if !ok {
+				vowel, ok = p.epentheticVowels[0]
+			}
+			if ok {
+				buf.WriteRune(vowel)
+				if origins != nil {
+					newOrigins = append(newOrigins, subword.Fill(string(vowel), origins[offset])...)
+				}
+			}
+		}
+
+		offset += size
+	}
+
+	return buf.String(), newOrigins
+}
+
+// isJaeum reports whether a letter is a consonant in Hangul Compatibility
+// Jamo.
+func isJaeum(ch rune) bool {
+	return 'ㄱ' <= ch && ch <= 'ㅎ'
+}
+
+// isMoeum reports whether a letter is a vowel in Hangul Compatibility Jamo.
+func isMoeum(ch rune) bool {
+	return 'ㅏ' <= ch && ch <= 'ㅣ'
+}
@@ -0,0 +1,37 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpentheticVowels(t *testing.T) {
+	h := hangulize.New(loadSpec("deu"))
+
+	for word, expected := range map[string]string{
+		"Bach":          "바흐",
+		"Martin Luther": "마르틴 루터",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+
+	h = h.With(hangulize.EpentheticVowels(map[rune]rune{0: 'ㅜ', 'ㅎ': 'ㅣ'}))
+
+	for word, expected := range map[string]string{
+		"Bach":          "바히",
+		"Martin Luther": "마루틴 루터",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+
+	a, err := hangulize.Align(h, "Bach")
+	require.NoError(t, err)
+	assert.Equal(t, "히", a.Syllables[1].Text)
+}
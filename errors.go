@@ -8,6 +8,28 @@ var ErrSpecNotFound = errors.New("spec not found")
 // ErrTranslit occurs when a transliteration has been failed.
 var ErrTranslit = errors.New("translit error")
 
-// ErrTranslitNotImported occurs when the selected spec requires a Translit but
-// it has not been imported yet.
-var ErrTranslitNotImported = errors.New("translit not imported")
+// ErrTranslitMissing occurs when the selected spec requires a Translit but it
+// has not been imported yet. The error message names the scheme. Use the
+// LenientTranslit option to skip the missing Translit instead.
+var ErrTranslitMissing = errors.New("translit missing")
+
+// ErrTranslitNotImported is the former name of ErrTranslitMissing.
+//
+// Deprecated: Use ErrTranslitMissing.
+var ErrTranslitNotImported = ErrTranslitMissing
+
+// ErrInvalidBundle occurs when a bundle is malformed or fails verification.
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+// ErrUnsigned occurs when an external spec or bundle has no signature but
+// unsigned sources are not allowed.
+var ErrUnsigned = errors.New("unsigned")
+
+// ErrBadSignature occurs when the signature of an external spec or bundle is
+// malformed or not made by a trusted key.
+var ErrBadSignature = errors.New("bad signature")
+
+// ErrBudgetExceeded occurs when a call of Hangulize has run out of the step
+// or time budget. The result is the partial output with the remaining rules
+// skipped.
+var ErrBudgetExceeded = errors.New("budget exceeded")
@@ -0,0 +1,127 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hangulize/hangulize/pkg/subword"
+)
+
+// FinalPolicy decides which consonants can be a final consonant (받침) in the
+// result.
+type FinalPolicy int
+
+const (
+	// FinalsAsIs keeps the final consonants as the spec writes.
+	FinalsAsIs FinalPolicy = iota
+
+	// FinalsStandard allows only the 7 final consonants in the loanword
+	// orthography: ㄱ, ㄴ, ㄹ, ㅁ, ㅂ, ㅅ and ㅇ. The other final consonants
+	// are neutralized, such as "ㅌ" into "ㅅ".
+	FinalsStandard
+
+	// FinalsPhonetic restores the final consonants which the loanword
+	// orthography neutralizes by the source letters: "Việt" -> "비엩"
+	// instead of "비엣".
+	FinalsPhonetic
+)
+
+// Finals chooses a policy for the final consonants. The policy is applied
+// after the "transcribe" step and traced as an "Overlay" step.
+func Finals(policy FinalPolicy) Option {
+	return func(o *options) {
+		o.finals = policy
+	}
+}
+
+// standardFinals neutralizes the final consonants into the 7 consonants.
+var standardFinals = map[rune]rune{
+	'ㄲ': 'ㄱ', 'ㅋ': 'ㄱ', 'ㄳ': 'ㄱ', 'ㄺ': 'ㄱ',
+	'ㄷ': 'ㅅ', 'ㅌ': 'ㅅ', 'ㅈ': 'ㅅ', 'ㅊ': 'ㅅ', 'ㅎ': 'ㅅ', 'ㅆ': 'ㅅ',
+	'ㅍ': 'ㅂ', 'ㄿ': 'ㅂ', 'ㅄ': 'ㅂ',
+	'ㄵ': 'ㄴ', 'ㄶ': 'ㄴ',
+	'ㄻ': 'ㅁ',
+	'ㄼ': 'ㄹ', 'ㄽ': 'ㄹ', 'ㄾ': 'ㄹ', 'ㅀ': 'ㄹ',
+}
+
+// phoneticFinals are the final consonants restored by the source letters.
+// The keys are the neutralized final consonants.
+var phoneticFinals = map[rune]map[rune]rune{
+	'ㅅ': {'t': 'ㅌ', 'd': 'ㄷ', 'j': 'ㅈ', 'т': 'ㅌ', 'д': 'ㄷ'},
+	'ㄱ': {'k': 'ㅋ', 'c': 'ㅋ', 'q': 'ㅋ', 'к': 'ㅋ'},
+	'ㅂ': {'p': 'ㅍ', 'f': 'ㅍ', 'п': 'ㅍ', 'ф': 'ㅍ'},
+}
+
+// applyFinals rewrites the final consonants in the transcribed subwords by
+// the policy. input is the word partitioned into the subwords. The origins of
+// the subwords are required for FinalsPhonetic.
+func (p procedure) applyFinals(subwords []subword.Subword, input string) []subword.Subword {
+	if p.finals == FinalsAsIs {
+		return subwords
+	}
+
+	changed := false
+
+	for i, sw := range subwords {
+		if sw.Level != 2 || !strings.ContainsRune(sw.Word, '-') {
+			continue
+		}
+
+		chars := []rune(sw.Word)
+		offset := 0
+
+		for j, ch := range chars {
+			if j > 0 && chars[j-1] == '-' {
+				final := ch
+
+				switch p.finals {
+				case FinalsStandard:
+					if std, ok := standardFinals[ch]; ok {
+						final = std
+					}
+				case FinalsPhonetic:
+					if sw.Origins != nil {
+						origin := sw.Origins[offset]
+						final = phoneticFinal(ch, input[origin.Start:origin.Stop])
+					}
+				}
+
+				if final != ch {
+					chars[j] = final
+					changed = true
+				}
+			}
+			offset += utf8.RuneLen(ch)
+		}
+
+		// The Jamo have the same length so that the origins are kept.
+		subwords[i].Word = string(chars)
+	}
+
+	if changed {
+		var b subword.Builder
+		b.Write(subwords...)
+		p.tracer.Overlay(b.String(), "finals")
+	}
+	return subwords
+}
+
+// phoneticFinal finds the final consonant for the source letters.
+func phoneticFinal(final rune, source string) rune {
+	restore, ok := phoneticFinals[final]
+	if !ok {
+		return final
+	}
+
+	// The last consonant in the source decides.
+	runes := []rune(strings.ToLower(source))
+	for i := len(runes) - 1; i >= 0; i-- {
+		if ch, ok := restore[runes[i]]; ok {
+			return ch
+		}
+		if strings.ContainsRune("aeiouyаеиоуыэюя", runes[i]) {
+			break
+		}
+	}
+	return final
+}
@@ -0,0 +1,58 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hangulizeFinals(t *testing.T, spec *hangulize.Spec, policy hangulize.FinalPolicy, word string) (string, []hangulize.Trace) {
+	h := hangulize.New(spec, hangulize.Finals(policy))
+
+	var traces []hangulize.Trace
+	h.Trace(func(t hangulize.Trace) { traces = append(traces, t) })
+
+	result, err := h.Hangulize(word)
+	require.NoError(t, err)
+	return result, traces
+}
+
+func TestFinalsPhonetic(t *testing.T) {
+	spec := loadSpec("vie")
+
+	result, _ := hangulizeFinals(t, spec, hangulize.FinalsAsIs, "Việt Nam")
+	assert.Equal(t, "비엣 남", result)
+
+	result, traces := hangulizeFinals(t, spec, hangulize.FinalsPhonetic, "Việt Nam")
+	assert.Equal(t, "비엩 남", result)
+	assert.Contains(t, traces, hangulize.Trace{Step: "Overlay", Word: "ㅂㅣㅔ-ㅌ ㄴㅏ-ㅁ", Why: "finals"})
+
+	// Not neutralized.
+	result, _ = hangulizeFinals(t, spec, hangulize.FinalsPhonetic, "Hà Nội")
+	assert.Equal(t, "하 노이", result)
+}
+
+func TestFinalsStandard(t *testing.T) {
+	spec := mustParseSpec(`
+	rewrite:
+		"t$" -> "T"
+
+	transcribe:
+		"a" -> "ㅏ"
+		"T" -> "-ㅌ"
+		"k" -> "-ㅋ"
+		"p" -> "ㅍ"
+	`)
+
+	result, _ := hangulizeFinals(t, spec, hangulize.FinalsAsIs, "pat")
+	assert.Equal(t, "팥", result)
+
+	result, traces := hangulizeFinals(t, spec, hangulize.FinalsStandard, "pat")
+	assert.Equal(t, "팟", result)
+	assert.Contains(t, traces, hangulize.Trace{Step: "Overlay", Word: "ㅍㅏ-ㅅ", Why: "finals"})
+
+	result, _ = hangulizeFinals(t, spec, hangulize.FinalsStandard, "pak")
+	assert.Equal(t, "팍", result)
+}
@@ -0,0 +1,350 @@
+package hangulize
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hangulize/hangulize/pkg/hsl"
+	"github.com/mattn/go-runewidth"
+)
+
+// FormatOptions customizes the canonical layout of FormatSpec.
+type FormatOptions struct {
+	// SortVars sorts the pairs in the "vars" section by their names. Comments
+	// just above a var move along with the var.
+	SortVars bool
+}
+
+// FormatSpec reformats an HSL source of a spec into the canonical layout:
+//
+//   - Section names start at the first column and sections are separated by
+//     a blank line.
+//   - Pairs and comments in a section are indented by 4 spaces.
+//   - "=" and "->" are aligned within a block of consecutive pairs.
+//   - Values are always double-quoted. Keys are double-quoted too, except the
+//     keys in the "lang" and "config" sections.
+//   - Runs of blank lines are collapsed into one.
+//
+// Comments are preserved. The formatted source parses to the same spec.
+func FormatSpec(src []byte, opts FormatOptions) ([]byte, error) {
+	// Reject a malformed source before formatting it.
+	if _, err := hsl.Parse(bytes.NewReader(src)); err != nil {
+		return nil, err
+	}
+
+	var f formatter
+	for i, line := range strings.Split(string(src), "\n") {
+		if err := f.feed(line); err != nil {
+			return nil, &hsl.Error{Line: i + 1, Err: err}
+		}
+	}
+
+	var buf bytes.Buffer
+	f.write(&buf, opts)
+	return buf.Bytes(), nil
+}
+
+// fmtLine is a parsed line of an HSL source. A line is a blank, a comment, or
+// a pair.
+type fmtLine struct {
+	comment string
+	blank   bool
+
+	key     string
+	op      string
+	values  []string
+	trailer string
+}
+
+func (l fmtLine) isPair() bool {
+	return l.op != ""
+}
+
+// fmtSection is a section in an HSL source. The prelude before the first
+// section is a section without name.
+type fmtSection struct {
+	name    string
+	trailer string
+	lines   []fmtLine
+}
+
+// formatter collects the lines of an HSL source into sections.
+type formatter struct {
+	sections []*fmtSection
+}
+
+func (f *formatter) current() *fmtSection {
+	if len(f.sections) == 0 {
+		f.sections = append(f.sections, &fmtSection{})
+	}
+	return f.sections[len(f.sections)-1]
+}
+
+// feed parses a line.
+func (f *formatter) feed(line string) error {
+	line = strings.TrimSpace(line)
+
+	switch {
+	case line == "":
+		f.current().lines = append(f.current().lines, fmtLine{blank: true})
+		return nil
+
+	case strings.HasPrefix(line, "#"):
+		f.current().lines = append(f.current().lines, fmtLine{comment: line})
+		return nil
+	}
+
+	key, rest, err := scanFmtString(line)
+	if err != nil {
+		return err
+	}
+	rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+
+	// Section name
+	if strings.HasPrefix(rest, ":") {
+		rest = strings.TrimSpace(rest[1:])
+		f.sections = append(f.sections, &fmtSection{name: key, trailer: rest})
+		return nil
+	}
+
+	// Pair
+	var op string
+	switch {
+	case strings.HasPrefix(rest, "="):
+		op = "="
+	case strings.HasPrefix(rest, "->"):
+		op = "->"
+	default:
+		return fmt.Errorf("unexpected %#v", rest)
+	}
+	rest = strings.TrimSpace(rest[len(op):])
+
+	var values []string
+	for rest != "" && !strings.HasPrefix(rest, "#") {
+		var val string
+
+		val, rest, err = scanFmtString(rest)
+		if err != nil {
+			return err
+		}
+		values = append(values, val)
+
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		rest = strings.TrimLeft(rest, ",")
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+
+	pair := fmtLine{key: key, op: op, values: values, trailer: rest}
+	f.current().lines = append(f.current().lines, pair)
+	return nil
+}
+
+// scanFmtString scans a quoted or bare string in the same way as the HSL
+// lexer. It returns the unquoted string and the remaining text.
+func scanFmtString(s string) (string, string, error) {
+	if s == "" {
+		return "", "", fmt.Errorf("string expected")
+	}
+
+	if s[0] != '"' {
+		i := strings.IndexFunc(s, func(ch rune) bool {
+			return ch != '_' && !unicode.IsLetter(ch) && !unicode.IsDigit(ch)
+		})
+		if i == 0 {
+			return "", "", fmt.Errorf("string expected: %#v", s)
+		}
+		if i == -1 {
+			i = len(s)
+		}
+		return s[:i], s[i:], nil
+	}
+
+	var buf strings.Builder
+	escaped := false
+
+	for i, ch := range s[1:] {
+		if ch == '"' && !escaped {
+			return buf.String(), s[i+2:], nil
+		}
+		if ch == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		buf.WriteRune(ch)
+	}
+
+	return "", "", fmt.Errorf("unterminated string: %#v", s)
+}
+
+// quoteFmtString double-quotes a string.
+func quoteFmtString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// isBareKey reports whether a key can be written without quotes.
+func isBareKey(s string) bool {
+	for i, ch := range s {
+		if ch == '_' || unicode.IsLetter(ch) {
+			continue
+		}
+		if i != 0 && unicode.IsDigit(ch) {
+			continue
+		}
+		return false
+	}
+	return s != ""
+}
+
+// write writes the collected sections in the canonical layout.
+func (f *formatter) write(buf *bytes.Buffer, opts FormatOptions) {
+	first := true
+
+	for _, sec := range f.sections {
+		lines := trimBlanks(sec.lines)
+
+		if sec.name == "" && len(lines) == 0 {
+			continue
+		}
+
+		if !first {
+			buf.WriteByte('\n')
+		}
+		first = false
+
+		indent := ""
+		if sec.name != "" {
+			buf.WriteString(sec.name + ":")
+			if sec.trailer != "" {
+				buf.WriteString(" " + sec.trailer)
+			}
+			buf.WriteByte('\n')
+			indent = "    "
+		}
+
+		bare := sec.name == "lang" || sec.name == "config"
+
+		if opts.SortVars && sec.name == "vars" {
+			lines = sortFmtPairs(lines)
+		}
+
+		writeFmtLines(buf, lines, indent, bare)
+	}
+}
+
+// trimBlanks collapses runs of blank lines and removes leading and trailing
+// blank lines.
+func trimBlanks(lines []fmtLine) []fmtLine {
+	var trimmed []fmtLine
+
+	for _, line := range lines {
+		if line.blank {
+			if len(trimmed) == 0 || trimmed[len(trimmed)-1].blank {
+				continue
+			}
+		}
+		trimmed = append(trimmed, line)
+	}
+
+	if len(trimmed) != 0 && trimmed[len(trimmed)-1].blank {
+		trimmed = trimmed[:len(trimmed)-1]
+	}
+
+	return trimmed
+}
+
+// sortFmtPairs sorts pairs by their keys. Comments just above a pair are
+// attached to the pair. Blank lines are dropped.
+func sortFmtPairs(lines []fmtLine) []fmtLine {
+	var (
+		groups   [][]fmtLine
+		comments []fmtLine
+	)
+
+	for _, line := range lines {
+		switch {
+		case line.blank:
+			continue
+		case line.isPair():
+			groups = append(groups, append(comments, line))
+			comments = nil
+		default:
+			comments = append(comments, line)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i][len(groups[i])-1].key < groups[j][len(groups[j])-1].key
+	})
+
+	var sorted []fmtLine
+	for _, group := range groups {
+		sorted = append(sorted, group...)
+	}
+
+	// Comments after the last pair stay at the end.
+	return append(sorted, comments...)
+}
+
+// writeFmtLines writes lines in a section. The operators of consecutive pairs
+// are aligned.
+func writeFmtLines(buf *bytes.Buffer, lines []fmtLine, indent string, bare bool) {
+	keys := make([]string, len(lines))
+	for i, line := range lines {
+		if !line.isPair() {
+			continue
+		}
+		if bare && isBareKey(line.key) {
+			keys[i] = line.key
+		} else {
+			keys[i] = quoteFmtString(line.key)
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		if !line.isPair() {
+			if line.comment != "" {
+				buf.WriteString(indent + line.comment)
+			}
+			buf.WriteByte('\n')
+			i++
+			continue
+		}
+
+		// Find the block of consecutive pairs.
+		j := i
+		width := 0
+		for ; j < len(lines) && lines[j].isPair(); j++ {
+			if w := runewidth.StringWidth(keys[j]); w > width {
+				width = w
+			}
+		}
+
+		for ; i < j; i++ {
+			line := lines[i]
+
+			buf.WriteString(indent)
+			buf.WriteString(keys[i])
+			buf.WriteString(strings.Repeat(" ", width-runewidth.StringWidth(keys[i])))
+			buf.WriteString(" " + line.op)
+
+			values := make([]string, len(line.values))
+			for k, val := range line.values {
+				values[k] = quoteFmtString(val)
+			}
+			if len(values) != 0 {
+				buf.WriteString(" " + strings.Join(values, ", "))
+			}
+
+			if line.trailer != "" {
+				buf.WriteString(" " + line.trailer)
+			}
+			buf.WriteByte('\n')
+		}
+	}
+}
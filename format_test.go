@@ -0,0 +1,96 @@
+package hangulize_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSpec(t *testing.T) {
+	src := `
+# prelude
+
+lang:
+  id = "ita"
+  english="Italian"
+vars:
+    "b" = "x"
+    a = "y", "z"
+rewrite:
+
+
+    "a" ->"b"
+    "ccc"   -> "d\"e"
+    # comment
+    "ff" -> ""
+
+`
+	res, err := hangulize.FormatSpec([]byte(src), hangulize.FormatOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `# prelude
+
+lang:
+    id      = "ita"
+    english = "Italian"
+
+vars:
+    "b" = "x"
+    "a" = "y", "z"
+
+rewrite:
+    "a"   -> "b"
+    "ccc" -> "d\"e"
+    # comment
+    "ff" -> ""
+`, string(res))
+
+	res, err = hangulize.FormatSpec([]byte(src), hangulize.FormatOptions{SortVars: true})
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `
+vars:
+    "a" = "y", "z"
+    "b" = "x"
+`)
+}
+
+func TestFormatSpecError(t *testing.T) {
+	_, err := hangulize.FormatSpec([]byte(`"a" -> "b"`), hangulize.FormatOptions{})
+	assert.Error(t, err)
+}
+
+func TestFormatBundledSpecs(t *testing.T) {
+	for _, lang := range hangulize.ListLangs() {
+		src, err := os.ReadFile("specs/" + lang + ".hsl")
+		require.NoError(t, err)
+
+		res, err := hangulize.FormatSpec(src, hangulize.FormatOptions{SortVars: true})
+		require.NoError(t, err, lang)
+
+		// Formatting is idempotent.
+		again, err := hangulize.FormatSpec(res, hangulize.FormatOptions{SortVars: true})
+		require.NoError(t, err, lang)
+		assert.Equal(t, string(res), string(again), lang)
+
+		// The formatted source is the same spec.
+		before, err := hangulize.ParseSpec(bytes.NewReader(src))
+		require.NoError(t, err, lang)
+		after, err := hangulize.ParseSpec(bytes.NewReader(res))
+		require.NoError(t, err, lang)
+
+		assert.Equal(t, before.Lang, after.Lang, lang)
+		assert.Equal(t, before.Config, after.Config, lang)
+		assert.Equal(t, before.Vars, after.Vars, lang)
+		assert.Equal(t, before.Test, after.Test, lang)
+		assert.Equal(t, len(before.Rewrite), len(after.Rewrite), lang)
+		for i := range before.Rewrite {
+			assert.Equal(t, before.Rewrite[i].String(), after.Rewrite[i].String(), lang)
+		}
+		for i := range before.Transcribe {
+			assert.Equal(t, before.Transcribe[i].String(), after.Transcribe[i].String(), lang)
+		}
+	}
+}
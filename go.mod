@@ -1,6 +1,6 @@
 module github.com/hangulize/hangulize
 
-go 1.19
+go 1.23
 
 require (
 	github.com/ikawaha/kagome.ipadic v1.1.2
@@ -9,7 +9,6 @@ require (
 	github.com/pkg/errors v0.9.1
 	github.com/spf13/cobra v1.6.1
 	github.com/stretchr/testify v1.8.1
-	github.com/suapapa/go_hangul v1.2.1
 	golang.org/x/text v0.5.0
 )
 
@@ -0,0 +1,408 @@
+package hangulize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GraphFormat is a language to describe a graph.
+type GraphFormat int
+
+const (
+	// Graphviz is the DOT language of Graphviz.
+	Graphviz GraphFormat = iota
+
+	// Mermaid is the flowchart syntax of Mermaid.
+	Mermaid
+)
+
+// RuleRef refers a rule in a spec. Step is "Rewrite" or "Transcribe" as
+// same as Trace.Step.
+type RuleRef struct {
+	Step string
+	ID   int
+}
+
+// RuleStats is measured data of a rule.
+type RuleStats struct {
+	// Hits is the number of words which the rule has changed.
+	Hits int
+
+	// Duration is the time spent on the rule.
+	Duration time.Duration
+}
+
+// GraphOptions customizes WriteGraph.
+type GraphOptions struct {
+	Format GraphFormat
+
+	// Stats annotates the rules with coverage or timing data. If it is not
+	// nil, the rules not in Stats are marked as uncovered.
+	Stats map[RuleRef]RuleStats
+}
+
+// WriteGraph writes a graph representation of a spec. The graph shows the
+// stages of the Hangulize procedure, the rules grouped by the blocks in the
+// HSL source, and the vars which the rules depend on.
+func WriteGraph(w io.Writer, spec *Spec, opts GraphOptions) error {
+	g := graphWriter{bufio.NewWriter(w), spec, opts}
+
+	switch opts.Format {
+	case Graphviz:
+		g.writeGraphviz()
+	case Mermaid:
+		g.writeMermaid()
+	default:
+		return fmt.Errorf("unknown graph format: %d", opts.Format)
+	}
+
+	return g.w.Flush()
+}
+
+// CountRuleHits aggregates traces into RuleStats.
+func CountRuleHits(traces []Trace) map[RuleRef]RuleStats {
+	stats := make(map[RuleRef]RuleStats)
+	for _, t := range traces {
+		if t.Rule == nil {
+			continue
+		}
+		ref := RuleRef{t.Step, t.Rule.ID}
+		s := stats[ref]
+		s.Hits++
+		stats[ref] = s
+	}
+	return stats
+}
+
+// -----------------------------------------------------------------------------
+
+// graphStage is a stage in the Hangulize procedure.
+type graphStage struct {
+	id    string
+	label string
+	rules []Rule
+}
+
+// ruleGroup is a block of consecutive rules in an HSL source. The label is
+// the comment above the block.
+type ruleGroup struct {
+	label string
+	ids   []int
+}
+
+type graphWriter struct {
+	w    *bufio.Writer
+	spec *Spec
+	opts GraphOptions
+}
+
+func (g graphWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.w, format, args...)
+}
+
+// stages lists the stages of the procedure for the spec.
+func (g graphWriter) stages() []graphStage {
+	stages := []graphStage{{id: "input", label: "Input"}}
+
+	if len(g.spec.Lang.Translit) != 0 {
+		label := "Transliterate: " + strings.Join(g.spec.Lang.Translit, ", ")
+		stages = append(stages, graphStage{id: "transliterate", label: label})
+	}
+
+	stages = append(stages,
+		graphStage{id: "normalize", label: "Normalize"},
+		graphStage{id: "rewrite", label: "Rewrite", rules: g.spec.Rewrite},
+		graphStage{id: "transcribe", label: "Transcribe", rules: g.spec.Transcribe},
+		graphStage{id: "syllabify", label: "Syllabify"},
+		graphStage{id: "localize", label: "Localize"},
+	)
+	return stages
+}
+
+// groups splits the rules in a stage into the blocks in the HSL source.
+func (g graphWriter) groups(stage graphStage) []ruleGroup {
+	groups := ruleGroups(g.spec.Source, stage.id)
+
+	// The source may not be available.
+	n := 0
+	for _, group := range groups {
+		n += len(group.ids)
+	}
+	if n != len(stage.rules) {
+		group := ruleGroup{}
+		for _, rule := range stage.rules {
+			group.ids = append(group.ids, rule.ID)
+		}
+		return []ruleGroup{group}
+	}
+
+	return groups
+}
+
+// ruleGroups finds the blocks of pairs in a section of an HSL source.
+func ruleGroups(source, section string) []ruleGroup {
+	var f formatter
+	for _, line := range strings.Split(source, "\n") {
+		if f.feed(line) != nil {
+			return nil
+		}
+	}
+
+	var (
+		groups []ruleGroup
+		group  ruleGroup
+		id     int
+	)
+
+	flush := func() {
+		if len(group.ids) != 0 {
+			groups = append(groups, group)
+		}
+		group = ruleGroup{}
+	}
+
+	for _, sec := range f.sections {
+		if sec.name != section {
+			continue
+		}
+
+		for _, line := range sec.lines {
+			switch {
+			case line.isPair():
+				group.ids = append(group.ids, id)
+				id++
+
+			case line.blank:
+				flush()
+
+			default:
+				if len(group.ids) != 0 {
+					flush()
+				}
+				label := strings.TrimSpace(strings.TrimLeft(line.comment, "#"))
+				if group.label == "" {
+					group.label = label
+				} else {
+					group.label += " " + label
+				}
+			}
+		}
+	}
+	flush()
+
+	return groups
+}
+
+// ruleLabel describes a rule with the annotation.
+func (g graphWriter) ruleLabel(stage graphStage, rule Rule) (string, bool) {
+	label := rule.String()
+
+	if g.opts.Stats == nil {
+		return label, true
+	}
+
+	stats, ok := g.opts.Stats[RuleRef{stage.label, rule.ID}]
+	if !ok || stats.Hits == 0 && stats.Duration == 0 {
+		return label, false
+	}
+
+	var notes []string
+	if stats.Hits != 0 {
+		notes = append(notes, fmt.Sprintf("hits: %d", stats.Hits))
+	}
+	if stats.Duration != 0 {
+		notes = append(notes, stats.Duration.String())
+	}
+	return label + "\n" + strings.Join(notes, ", "), true
+}
+
+// varDeps collects the vars referenced by the rules in the stages. The
+// result maps var names to the node IDs of the rules in the order of names.
+func (g graphWriter) varDeps(stages []graphStage) ([]string, map[string][]string) {
+	deps := make(map[string][]string)
+
+	for _, stage := range stages {
+		for _, rule := range stage.rules {
+			seen := make(map[string]bool)
+			for _, name := range append(rule.From.Vars(), rule.To.Vars()...) {
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+				deps[name] = append(deps[name], ruleNodeID(stage, rule))
+			}
+		}
+	}
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, deps
+}
+
+func ruleNodeID(stage graphStage, rule Rule) string {
+	return fmt.Sprintf("%s_%d", stage.id, rule.ID)
+}
+
+// -----------------------------------------------------------------------------
+// Graphviz
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func (g graphWriter) writeGraphviz() {
+	stages := g.stages()
+
+	g.printf("digraph %s {\n", dotQuote(g.spec.Lang.ID))
+	g.printf("  compound=true;\n")
+	g.printf("  node [shape=box];\n")
+
+	for _, stage := range stages {
+		if len(stage.rules) == 0 {
+			g.printf("  %s [label=%s, shape=oval];\n", stage.id, dotQuote(stage.label))
+			continue
+		}
+
+		g.printf("  subgraph cluster_%s {\n", stage.id)
+		g.printf("    label=%s;\n", dotQuote(stage.label))
+
+		for i, group := range g.groups(stage) {
+			g.printf("    subgraph cluster_%s_%d {\n", stage.id, i)
+			g.printf("      label=%s;\n", dotQuote(group.label))
+
+			for _, id := range group.ids {
+				rule := stage.rules[id]
+				label, covered := g.ruleLabel(stage, rule)
+
+				style := ""
+				if !covered {
+					style = ", style=dashed, color=gray"
+				}
+				g.printf("      %s [label=%s%s];\n", ruleNodeID(stage, rule), dotQuote(label), style)
+			}
+
+			g.printf("    }\n")
+		}
+
+		g.printf("  }\n")
+	}
+
+	// Connect the stages. An edge from or to a cluster is drawn between the
+	// nodes in the cluster but clipped by the cluster.
+	for i := 1; i < len(stages); i++ {
+		tail, head := stages[i-1], stages[i]
+
+		var attrs []string
+		tailNode, headNode := tail.id, head.id
+
+		if len(tail.rules) != 0 {
+			tailNode = ruleNodeID(tail, tail.rules[len(tail.rules)-1])
+			attrs = append(attrs, "ltail=cluster_"+tail.id)
+		}
+		if len(head.rules) != 0 {
+			headNode = ruleNodeID(head, head.rules[0])
+			attrs = append(attrs, "lhead=cluster_"+head.id)
+		}
+
+		if len(attrs) == 0 {
+			g.printf("  %s -> %s;\n", tailNode, headNode)
+		} else {
+			g.printf("  %s -> %s [%s];\n", tailNode, headNode, strings.Join(attrs, ", "))
+		}
+	}
+
+	// Connect the vars to the rules.
+	names, deps := g.varDeps(stages)
+	for i, name := range names {
+		g.printf("  var_%d [label=%s, shape=hexagon];\n", i, dotQuote("<"+name+">"))
+		for _, node := range deps[name] {
+			g.printf("  var_%d -> %s [style=dotted, arrowhead=none];\n", i, node)
+		}
+	}
+
+	g.printf("}\n")
+}
+
+// -----------------------------------------------------------------------------
+// Mermaid
+
+func mermaidQuote(s string) string {
+	r := strings.NewReplacer(
+		`"`, "#quot;",
+		"<", "#lt;",
+		">", "#gt;",
+		"\n", "<br>",
+	)
+	return `"` + r.Replace(s) + `"`
+}
+
+func (g graphWriter) writeMermaid() {
+	stages := g.stages()
+
+	g.printf("flowchart TD\n")
+
+	var uncovered []string
+
+	for _, stage := range stages {
+		if len(stage.rules) == 0 {
+			g.printf("  %s([%s])\n", stage.id, mermaidQuote(stage.label))
+			continue
+		}
+
+		g.printf("  subgraph %s [%s]\n", stage.id, mermaidQuote(stage.label))
+
+		for i, group := range g.groups(stage) {
+			label := group.label
+			if label == "" {
+				// An empty label is not allowed.
+				label = " "
+			}
+			g.printf("    subgraph %s_g%d [%s]\n", stage.id, i, mermaidQuote(label))
+
+			for _, id := range group.ids {
+				rule := stage.rules[id]
+				label, covered := g.ruleLabel(stage, rule)
+
+				node := ruleNodeID(stage, rule)
+				if !covered {
+					uncovered = append(uncovered, node)
+				}
+				g.printf("      %s[%s]\n", node, mermaidQuote(label))
+			}
+
+			g.printf("    end\n")
+		}
+
+		g.printf("  end\n")
+	}
+
+	// Connect the stages.
+	for i := 1; i < len(stages); i++ {
+		g.printf("  %s --> %s\n", stages[i-1].id, stages[i].id)
+	}
+
+	// Connect the vars to the rules.
+	names, deps := g.varDeps(stages)
+	for i, name := range names {
+		g.printf("  var_%d{{%s}}\n", i, mermaidQuote("<"+name+">"))
+		for _, node := range deps[name] {
+			g.printf("  var_%d -.- %s\n", i, node)
+		}
+	}
+
+	if len(uncovered) != 0 {
+		g.printf("  classDef uncovered stroke-dasharray:5 5,color:#999\n")
+		g.printf("  class %s uncovered\n", strings.Join(uncovered, ","))
+	}
+}
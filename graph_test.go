@@ -0,0 +1,75 @@
+package hangulize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphHSL = `
+lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+vars:
+    "vowels" = "a", "o"
+
+rewrite:
+    # Double consonants.
+    "cc" -> "c"
+
+    "c{<vowels>}" -> "k"
+
+transcribe:
+    "k" -> "ㅋ"
+    "c" -> "ㅋ"
+    "a" -> "ㅏ"
+    "o" -> "ㅗ"
+`
+
+func TestWriteGraphviz(t *testing.T) {
+	spec := mustParseSpec(graphHSL)
+
+	var buf strings.Builder
+	err := hangulize.WriteGraph(&buf, spec, hangulize.GraphOptions{})
+	require.NoError(t, err)
+
+	dot := buf.String()
+	assert.True(t, strings.HasPrefix(dot, `digraph "test" {`))
+	assert.Contains(t, dot, `label="Double consonants.";`)
+	assert.Contains(t, dot, `rewrite_1 [label="\"c{<vowels>}\" -> \"k\""];`)
+	assert.Contains(t, dot, `normalize -> rewrite_0 [lhead=cluster_rewrite];`)
+	assert.Contains(t, dot, `var_0 -> rewrite_1 [style=dotted, arrowhead=none];`)
+}
+
+func TestWriteMermaid(t *testing.T) {
+	spec := mustParseSpec(graphHSL)
+
+	h := hangulize.New(spec)
+	var traces []hangulize.Trace
+	h.Trace(func(tr hangulize.Trace) {
+		traces = append(traces, tr)
+	})
+	_, err := h.Hangulize("coca")
+	require.NoError(t, err)
+
+	stats := hangulize.CountRuleHits(traces)
+	assert.Equal(t, 1, stats[hangulize.RuleRef{Step: "Rewrite", ID: 1}].Hits)
+
+	var buf strings.Builder
+	err = hangulize.WriteGraph(&buf, spec, hangulize.GraphOptions{
+		Format: hangulize.Mermaid,
+		Stats:  stats,
+	})
+	require.NoError(t, err)
+
+	mmd := buf.String()
+	assert.True(t, strings.HasPrefix(mmd, "flowchart TD\n"))
+	assert.Contains(t, mmd, `rewrite_1["#quot;c{#lt;vowels#gt;}#quot; -#gt; #quot;k#quot;<br>hits: 1"]`)
+	assert.Contains(t, mmd, "normalize --> rewrite\n")
+	assert.Contains(t, mmd, "class rewrite_0,transcribe_1 uncovered\n")
+}
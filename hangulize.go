@@ -1,5 +1,7 @@
 package hangulize
 
+import "fmt"
+
 // Hangulize transcribes a non-Korean word into Hangul, which is the Korean
 // alphabet.
 //
@@ -12,7 +14,7 @@ func Hangulize(lang string, word string) (string, error) {
 		return word, err
 	}
 
-	h := &hangulizer{spec, defaultTranslitRegistry, nil}
+	h := &hangulizer{spec: spec, translitRegistry: defaultTranslitRegistry}
 	return h.Hangulize(word)
 }
 
@@ -33,7 +35,22 @@ type Hangulizer interface {
 	// Trace registers a tracing function.
 	Trace(func(Trace))
 
-	// Hangulize transcribes a non-Korean word into Hangul.
+	// With creates a copy of the Hangulizer with the options overlaid. The
+	// copy shares the compiled Spec and the imported Translits at the moment
+	// so that it is cheap to create.
+	With(opts ...Option) Hangulizer
+
+	// AddRule compiles and appends a rule to the "rewrite" or "transcribe"
+	// stage. The underlying Spec is cloned at the first modification so that
+	// the original Spec is not affected.
+	AddRule(stage, pattern, replacement string) (Rule, error)
+
+	// RemoveRule removes a rule by the ID from the "rewrite" or "transcribe"
+	// stage. The IDs of the following rules are shifted.
+	RemoveRule(stage string, id int) bool
+
+	// Hangulize transcribes a non-Korean word into Hangul. If the budget has
+	// been exceeded, it returns the partial output with ErrBudgetExceeded.
 	Hangulize(word string) (string, error)
 }
 
@@ -42,11 +59,19 @@ type hangulizer struct {
 	spec             *Spec
 	translitRegistry translitRegistry
 	traceFunc        func(Trace)
+	opts             options
+
+	// ownSpec is true if the spec has been cloned for rule modifications.
+	ownSpec bool
 }
 
 // New creates a hangulizer for a Spec.
-func New(spec *Spec) Hangulizer {
-	return &hangulizer{spec, make(translitRegistry), nil}
+func New(spec *Spec, opts ...Option) Hangulizer {
+	h := &hangulizer{spec: spec, translitRegistry: make(translitRegistry)}
+	for _, opt := range opts {
+		opt(&h.opts)
+	}
+	return h
 }
 
 // Spec returns the underlying Spec.
@@ -76,6 +101,127 @@ func (h *hangulizer) Trace(fn func(Trace)) {
 
 // Hangulize transcribes a non-Korean word into Hangul.
 func (h *hangulizer) Hangulize(word string) (string, error) {
+	return h.procedure().forward(word)
+}
+
+// introspectable is implemented by the Hangulizers in this package so that
+// NewReport reproduces their transcriptions with the effective options and
+// exceptions.
+type introspectable interface {
+	effectiveOptions() options
+	procedure() *procedure
+	exception(word string) (string, bool)
+}
+
+func (h *hangulizer) effectiveOptions() options {
+	return h.opts
+}
+
+// exception always fails because a hangulizer has no exceptions.
+func (h *hangulizer) exception(string) (string, bool) {
+	return "", false
+}
+
+// procedure prepares a procedure with the options.
+func (h *hangulizer) procedure() *procedure {
 	p := newProcedure(h.Spec(), h.Translits(), h.traceFunc)
-	return p.forward(word)
+	p.lenientTranslit = h.opts.lenientTranslit
+	p.koreanTypography = h.opts.koreanTypography
+	p.joinNames = h.opts.joinNames
+	p.syllableSep = h.opts.syllableSep
+	p.groupSep = h.opts.groupSep
+	p.epentheticVowels = h.opts.epentheticVowels
+	p.finals = h.opts.finals
+	p.longVowels = h.opts.longVowels
+	p.hyphens = h.opts.hyphens
+	p.apostrophes = h.opts.apostrophes
+
+	// The overlays find the source letters by the origins.
+	p.track = h.opts.finals == FinalsPhonetic || h.opts.longVowels != LongVowelsAsIs
+
+	p.budget = newBudget(h.opts.stepBudget, h.opts.timeBudget)
+	p.logger = h.opts.logger
+	return p
+}
+
+// With creates a copy of the hangulizer with the options overlaid.
+func (h *hangulizer) With(opts ...Option) Hangulizer {
+	copied := *h
+	copied.translitRegistry = translitRegistry(h.translitRegistry.Detach())
+
+	// The spec is shared. Both of them should clone it again to modify it.
+	copied.ownSpec = false
+	h.ownSpec = false
+
+	for _, opt := range opts {
+		opt(&copied.opts)
+	}
+	return &copied
+}
+
+// AddRule compiles and appends a rule to the "rewrite" or "transcribe" stage.
+func (h *hangulizer) AddRule(stage, pattern, replacement string) (Rule, error) {
+	rules, err := h.stageRules(stage)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule, err := newRule(len(*rules), pattern, replacement, h.spec.Macros, h.spec.Vars)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	h.cloneSpec()
+	rules, _ = h.stageRules(stage)
+	*rules = append(*rules, rule)
+
+	h.spec.puncts = collectPuncts(h.spec.Rewrite, h.spec.Transcribe)
+	return rule, nil
+}
+
+// RemoveRule removes a rule by the ID from the "rewrite" or "transcribe"
+// stage.
+func (h *hangulizer) RemoveRule(stage string, id int) bool {
+	rules, err := h.stageRules(stage)
+	if err != nil || id < 0 || id >= len(*rules) {
+		return false
+	}
+
+	h.cloneSpec()
+	rules, _ = h.stageRules(stage)
+
+	remaining := append((*rules)[:id], (*rules)[id+1:]...)
+	for i := id; i < len(remaining); i++ {
+		remaining[i].ID = i
+	}
+	*rules = remaining
+
+	h.spec.puncts = collectPuncts(h.spec.Rewrite, h.spec.Transcribe)
+	return true
+}
+
+// stageRules chooses the rules in the spec by the stage name.
+func (h *hangulizer) stageRules(stage string) (*[]Rule, error) {
+	switch stage {
+	case "rewrite":
+		return &h.spec.Rewrite, nil
+	case "transcribe":
+		return &h.spec.Transcribe, nil
+	}
+	return nil, fmt.Errorf("unknown stage: %s", stage)
+}
+
+// cloneSpec copies the spec and its rules to modify them privately.
+func (h *hangulizer) cloneSpec() {
+	if h.ownSpec {
+		return
+	}
+
+	spec := *h.spec
+	spec.Rewrite = append([]Rule(nil), spec.Rewrite...)
+	spec.Transcribe = append([]Rule(nil), spec.Transcribe...)
+	spec.modified = true
+
+	h.spec = &spec
+	h.ownSpec = true
 }
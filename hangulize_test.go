@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/furigana"
+	"github.com/hangulize/hangulize/translit/romaji"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestLang generates subtests for bundled lang specs.
@@ -214,6 +217,188 @@ func TestInstanceTranslit(t *testing.T) {
 	assert.ErrorIs(t, err, hangulize.ErrTranslitNotImported)
 }
 
+func TestLenientTranslit(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+		translit = "stub"
+
+	transcribe:
+		"a" -> "ㅏ"
+	`)
+
+	_, err := hangulize.New(spec).Hangulize("a")
+	assert.ErrorIs(t, err, hangulize.ErrTranslitMissing)
+	assert.EqualError(t, err, "translit missing: stub")
+
+	h := hangulize.New(spec, hangulize.LenientTranslit(true))
+	result, err := h.Hangulize("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "아", result)
+}
+
+func TestOptionalTranslit(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+
+		optional_translit = "stub"
+
+	transcribe:
+		"a"    -> "ㅏ"
+		"stub" -> "스텁"
+	`)
+	h := hangulize.New(spec)
+
+	// A missing optional Translit is skipped.
+	result, err := h.Hangulize("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "아", result)
+
+	h.UseTranslit(&stubTranslit{})
+	result, err = h.Hangulize("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "스텁", result)
+}
+
+func TestOptionalRomaji(t *testing.T) {
+	h := hangulize.New(loadSpec("jpn"))
+	h.UseTranslit(furigana.T)
+
+	result, err := h.Hangulize("東京 Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, "도쿄 Tokyo", result)
+
+	h.UseTranslit(romaji.T)
+	result, err = h.Hangulize("東京 Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, "도쿄 도쿄", result)
+}
+
+func TestAddRemoveRule(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id     = "test"
+		codes  = "xx", "xxx"
+		script = "Latn"
+
+	transcribe:
+		"a" -> "ㅏ"
+		"b" -> "ㅂ"
+		"c" -> "ㅋ"
+	`)
+	h := hangulize.New(spec)
+
+	rule, err := h.AddRule("rewrite", "^b", "p")
+	require.NoError(t, err)
+	assert.Equal(t, 0, rule.ID)
+	_, err = h.AddRule("transcribe", "p", "ㅍ")
+	require.NoError(t, err)
+
+	result, err := h.Hangulize("ba")
+	require.NoError(t, err)
+	assert.Equal(t, "파", result)
+
+	assert.True(t, h.RemoveRule("transcribe", 1))
+	assert.Equal(t, []int{0, 1, 2}, []int{
+		h.Spec().Transcribe[0].ID,
+		h.Spec().Transcribe[1].ID,
+		h.Spec().Transcribe[2].ID,
+	})
+	assert.Equal(t, "p", h.Spec().Transcribe[2].From.String())
+	assert.False(t, h.RemoveRule("transcribe", 3))
+
+	// The original spec is not affected.
+	assert.Empty(t, spec.Rewrite)
+	assert.Len(t, spec.Transcribe, 3)
+	assert.Equal(t, "b", spec.Transcribe[1].From.String())
+
+	_, err = h.AddRule("unknown", "a", "b")
+	assert.Error(t, err)
+	_, err = h.AddRule("rewrite", "{~a+}", "b")
+	assert.Error(t, err)
+}
+
+func TestWith(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+		translit = "stub"
+
+	transcribe:
+		"a" -> "ㅏ"
+	`)
+	h := hangulize.New(spec)
+	lenient := h.With(hangulize.LenientTranslit(true))
+
+	assert.Same(t, h.Spec(), lenient.Spec())
+
+	_, err := h.Hangulize("a")
+	assert.ErrorIs(t, err, hangulize.ErrTranslitMissing)
+
+	result, err := lenient.Hangulize("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "아", result)
+
+	// Modifications don't leak into each other.
+	_, err = lenient.AddRule("transcribe", "b", "ㅂ")
+	require.NoError(t, err)
+	assert.Len(t, h.Spec().Transcribe, 1)
+	assert.Len(t, lenient.Spec().Transcribe, 2)
+
+	lenient.UseTranslit(&stubTranslit{})
+	assert.Empty(t, h.Translits())
+}
+
+func TestWithModifiedOriginal(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id    = "test"
+		codes = "xx", "xxx"
+
+	transcribe:
+		"b" -> "ㅂ"
+	`)
+	h := hangulize.New(spec)
+
+	_, err := h.AddRule("transcribe", "a", "ㅏ")
+	require.NoError(t, err)
+
+	copied := h.With()
+
+	result, err := copied.Hangulize("ab")
+	require.NoError(t, err)
+	assert.Equal(t, "아브", result)
+
+	// The original owned the spec before With. Its modifications after With
+	// don't leak into the copy.
+	assert.True(t, h.RemoveRule("transcribe", 0))
+	assert.Len(t, h.Spec().Transcribe, 1)
+
+	result, err = copied.Hangulize("ab")
+	require.NoError(t, err)
+	assert.Equal(t, "아브", result)
+}
+
+func TestSpacePreservation(t *testing.T) {
+	assert.Equal(t, "로마  밀라노", mustHangulize(t, "ita", "Roma  Milano"))
+	assert.Equal(t, "로마\t밀라노\n", mustHangulize(t, "ita", "Roma\tMilano\n"))
+	assert.Equal(t, " «로마», ", mustHangulize(t, "ita", " «Roma», "))
+}
+
+func TestKoreanTypography(t *testing.T) {
+	spec, err := hangulize.LoadSpec("ita")
+	require.NoError(t, err)
+	h := hangulize.New(spec, hangulize.KoreanTypography(true))
+
+	result, err := h.Hangulize("«Roma»  –  Milano")
+	require.NoError(t, err)
+	assert.Equal(t, "“로마” — 밀라노", result)
+}
+
 // -----------------------------------------------------------------------------
 // Examples
 
@@ -0,0 +1,33 @@
+// Package buildinfo reads the versions of the modules in the running binary.
+package buildinfo
+
+import "runtime/debug"
+
+// Unknown is the version of a module not found in the build information.
+const Unknown = "unknown"
+
+// ModuleVersion returns the version of a module which the running binary
+// has been built with. A module built from a working copy may have
+// "(devel)" as the version.
+func ModuleVersion(path string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Unknown
+	}
+
+	if info.Main.Path == path {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path != path {
+			continue
+		}
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		return dep.Version
+	}
+
+	return Unknown
+}
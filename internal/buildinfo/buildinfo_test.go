@@ -0,0 +1,12 @@
+package buildinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleVersion(t *testing.T) {
+	assert.Equal(t, "v1.8.1", ModuleVersion("github.com/stretchr/testify"))
+	assert.Equal(t, Unknown, ModuleVersion("example.com/nothing"))
+}
@@ -0,0 +1,116 @@
+package jamo
+
+// archaicJaeums are the conjoining lead and tail forms of the obsolete
+// consonants in Hangul Compatibility Jamo. A zero means that the consonant
+// has no form for the position.
+var archaicJaeums = map[rune][2]rune{
+	'ㅥ': {'ᄔ', 'ᇿ'}, // SSANGNIEUN
+	'ㅦ': {'ᄕ', 'ᇆ'}, // NIEUN-TIKEUT
+	'ㅧ': {'ᅜ', 'ᇇ'}, // NIEUN-SIOS
+	'ㅨ': {0, 'ᇈ'},   // NIEUN-PANSIOS
+	'ㅩ': {0, 'ᇌ'},   // RIEUL-KIYEOK-SIOS
+	'ㅪ': {0, 'ᇎ'},   // RIEUL-TIKEUT
+	'ㅫ': {0, 'ᇓ'},   // RIEUL-PIEUP-SIOS
+	'ㅬ': {0, 'ᇗ'},   // RIEUL-PANSIOS
+	'ㅭ': {0, 'ᇙ'},   // RIEUL-YEORINHIEUH
+	'ㅮ': {'ᄜ', 'ᇜ'}, // MIEUM-PIEUP
+	'ㅯ': {0, 'ᇝ'},   // MIEUM-SIOS
+	'ㅰ': {0, 'ᇟ'},   // MIEUM-PANSIOS
+	'ㅱ': {'ᄝ', 'ᇢ'}, // KAPYEOUNMIEUM
+	'ㅲ': {'ᄞ', 0},   // PIEUP-KIYEOK
+	'ㅳ': {'ᄠ', 0},   // PIEUP-TIKEUT
+	'ㅴ': {'ᄢ', 0},   // PIEUP-SIOS-KIYEOK
+	'ㅵ': {'ᄣ', 0},   // PIEUP-SIOS-TIKEUT
+	'ㅶ': {'ᄧ', 0},   // PIEUP-CIEUC
+	'ㅷ': {'ᄩ', 0},   // PIEUP-THIEUTH
+	'ㅸ': {'ᄫ', 'ᇦ'}, // KAPYEOUNPIEUP
+	'ㅹ': {'ᄬ', 0},   // KAPYEOUNSSANGPIEUP
+	'ㅺ': {'ᄭ', 0},   // SIOS-KIYEOK
+	'ㅻ': {'ᄮ', 0},   // SIOS-NIEUN
+	'ㅼ': {'ᄯ', 0},   // SIOS-TIKEUT
+	'ㅽ': {'ᄲ', 0},   // SIOS-PIEUP
+	'ㅾ': {'ᄶ', 0},   // SIOS-CIEUC
+	'ㅿ': {'ᅀ', 'ᇫ'}, // PANSIOS
+	'ㆀ': {'ᅇ', 0},   // SSANGIEUNG
+	'ㆁ': {'ᅌ', 'ᇰ'}, // YESIEUNG
+	'ㆂ': {0, 'ᇱ'},   // YESIEUNG-SIOS
+	'ㆃ': {0, 'ᇲ'},   // YESIEUNG-PANSIOS
+	'ㆄ': {'ᅗ', 0},   // KAPYEOUNPHIEUPH
+	'ㆅ': {'ᅘ', 0},   // SSANGHIEUH
+	'ㆆ': {'ᅙ', 'ᇹ'}, // YEORINHIEUH
+}
+
+// archaicMoeums are the conjoining forms of the obsolete vowels in Hangul
+// Compatibility Jamo.
+var archaicMoeums = map[rune]rune{
+	'ㆇ': 'ᆄ', // YO-YA
+	'ㆈ': 'ᆅ', // YO-YAE
+	'ㆉ': 'ᆈ', // YO-I
+	'ㆊ': 'ᆑ', // YU-YEO
+	'ㆋ': 'ᆒ', // YU-YE
+	'ㆌ': 'ᆔ', // YU-I
+	'ㆍ': 'ᆞ', // ARAEA
+	'ㆎ': 'ᆡ', // ARAEAE
+}
+
+// The range of the obsolete Jamo in Hangul Compatibility Jamo. It is checked
+// before the maps because most Jamo are modern.
+const (
+	archaicFirst = 'ㅥ'
+	archaicLast  = 'ㆎ'
+)
+
+func isArchaicJaeum(ch rune) bool {
+	if ch < archaicFirst || archaicLast < ch {
+		return false
+	}
+	_, ok := archaicJaeums[ch]
+	return ok
+}
+
+func isArchaicMoeum(ch rune) bool {
+	if ch < archaicFirst || archaicLast < ch {
+		return false
+	}
+	_, ok := archaicMoeums[ch]
+	return ok
+}
+
+// isArchaic reports whether a buffered Jamo is obsolete.
+func isArchaic(ch rune) bool {
+	return isArchaicJaeum(ch) || isArchaicMoeum(ch)
+}
+
+// writeConjoining writes a letter as a sequence of conjoining Jamo because a
+// precomposed syllable for it doesn't exist. A tail without a conjoining form
+// is written in Hangul Compatibility Jamo after the sequence.
+func (c *Composer) writeConjoining(l, m, t rune) {
+	if forms, ok := archaicJaeums[l]; ok && forms[0] != 0 {
+		c.writeRune(forms[0])
+	} else if ll := leadForm(l); ll != 0 {
+		c.writeRune(ll)
+	} else {
+		// An obsolete consonant without a lead form is filled.
+		c.writeRune(conjoiningIeung)
+		defer c.writeRune(l)
+	}
+
+	if mm, ok := archaicMoeums[m]; ok {
+		c.writeRune(mm)
+	} else {
+		c.writeRune(medialForm(m))
+	}
+
+	if t == 0 {
+		return
+	}
+	if forms, ok := archaicJaeums[t]; ok {
+		if forms[1] != 0 {
+			c.writeRune(forms[1])
+		} else {
+			c.writeRune(t)
+		}
+	} else if tt := tailForm(t); tt != 0 {
+		c.writeRune(tt)
+	}
+}
@@ -0,0 +1,21 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeArchaic(t *testing.T) {
+	// The letters in Hunminjeongeum.
+	assert.Equal(t, "\u1112\u119e\u11ab", ComposeHangul("ㅎㆍ-ㄴ"))
+	assert.Equal(t, "\u1140\u1175", ComposeHangul("ㅿㅣ"))
+	assert.Equal(t, "\u112b\u1161", ComposeHangul("ㅸㅏ"))
+	assert.Equal(t, "\u1159\u1161\u11bc", ComposeHangul("ㆆㅏ-ㅇ"))
+
+	// An obsolete tail.
+	assert.Equal(t, "\u1109\u1161\u11eb", ComposeHangul("ㅅㅏ-ㅿ"))
+
+	// The modern letters are still precomposed.
+	assert.Equal(t, "나랏말\u110a\u119e\u11b7", ComposeHangul("ㄴㅏㄹㅏ-ㅅㅁㅏ-ㄹㅆㆍ-ㅁ"))
+}
@@ -0,0 +1,32 @@
+package jamo
+
+// WithSmartAttach makes a Composer merge a buffered lead with a following
+// syllable with the vowel carrier "ㅇ", such as "ㄱ이" -> "기". It is enabled
+// by default. If it is disabled, the lead is composed into a separate letter,
+// such as "ㄱ이" -> "그이".
+func WithSmartAttach(enabled bool) Option {
+	return func(c *Composer) {
+		c.noSmartAttach = !enabled
+	}
+}
+
+// attach merges a buffered lead with a composed syllable which starts with
+// the vowel carrier "ㅇ". It reports whether they have been merged.
+func (c *Composer) attach(ch rune) bool {
+	if c.noSmartAttach || c.score != lead || c.lmt[lead] == 0 {
+		return false
+	}
+
+	l, m, t := split(ch)
+	if l != 'ㅇ' {
+		return false
+	}
+
+	c.lmt[medial], c.lmt[tail] = m, t
+	if t == 0 {
+		c.score = medial
+	} else {
+		c.score = tail
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmartAttach(t *testing.T) {
+	assert.Equal(t, "기", ComposeHangul("ㄱ이"))
+	assert.Equal(t, "뱌", ComposeHangul("ㅂ야"))
+	assert.Equal(t, "간", ComposeHangul("ㄱ안"))
+	assert.Equal(t, "간다", ComposeHangul("ㄱ아-ㄴㄷㅏ"))
+
+	// Only a buffered lead is merged.
+	assert.Equal(t, "가이", ComposeHangul("ㄱㅏ이"))
+	assert.Equal(t, "그기", ComposeHangul("ㄱ기"))
+}
+
+func TestSmartAttachDisabled(t *testing.T) {
+	assert.Equal(t, "그이", ComposeHangul("ㄱ이", WithSmartAttach(false)))
+	assert.Equal(t, "브야", ComposeHangul("ㅂ야", WithSmartAttach(false)))
+}
@@ -0,0 +1,35 @@
+package jamo
+
+// clusterTails are the tails merged from 2 consecutive tails.
+var clusterTails = map[[2]rune]rune{
+	{'ㄱ', 'ㄱ'}: 'ㄲ',
+	{'ㄱ', 'ㅅ'}: 'ㄳ',
+	{'ㄴ', 'ㅈ'}: 'ㄵ',
+	{'ㄴ', 'ㅎ'}: 'ㄶ',
+	{'ㄹ', 'ㄱ'}: 'ㄺ',
+	{'ㄹ', 'ㅁ'}: 'ㄻ',
+	{'ㄹ', 'ㅂ'}: 'ㄼ',
+	{'ㄹ', 'ㅅ'}: 'ㄽ',
+	{'ㄹ', 'ㅌ'}: 'ㄾ',
+	{'ㄹ', 'ㅍ'}: 'ㄿ',
+	{'ㄹ', 'ㅎ'}: 'ㅀ',
+	{'ㅂ', 'ㅅ'}: 'ㅄ',
+	{'ㅅ', 'ㅅ'}: 'ㅆ',
+}
+
+// mergeTail merges a tail into the buffered tail, such as "ㅂ" and "ㅅ" into
+// "ㅄ" for "ㄱㅏ-ㅂ-ㅅ" -> "값". It reports whether they have been merged.
+func (c *Composer) mergeTail(ch rune) bool {
+	prev := c.lmt[tail]
+	if prev == 0 {
+		return false
+	}
+
+	cluster, ok := clusterTails[[2]rune{prev, ch}]
+	if !ok {
+		return false
+	}
+
+	c.lmt[tail] = cluster
+	return true
+}
@@ -0,0 +1,23 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeClusterTails(t *testing.T) {
+	assert.Equal(t, "값", ComposeHangul("ㄱㅏ-ㅂ-ㅅ"))
+	assert.Equal(t, "닭", ComposeHangul("ㄷㅏ-ㄹ-ㄱ"))
+	assert.Equal(t, "앉아", ComposeHangul("ㅇㅏ-ㄴ-ㅈㅇㅏ"))
+	assert.Equal(t, "있다", ComposeHangul("ㅇㅣ-ㅅ-ㅅㄷㅏ"))
+
+	// On a composed syllable.
+	assert.Equal(t, "값", ComposeHangul("갑-ㅅ"))
+
+	// Not a cluster.
+	assert.Equal(t, "간은", ComposeHangul("ㄱㅏ-ㄴ-ㄴ"))
+
+	// A lead after a tail is not merged.
+	assert.Equal(t, "갑사", ComposeHangul("ㄱㅏ-ㅂㅅㅏ"))
+}
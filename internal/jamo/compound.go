@@ -0,0 +1,39 @@
+package jamo
+
+// compoundVowels are the vowels merged from 2 consecutive vowels.
+var compoundVowels = map[[2]rune]rune{
+	{'ㅗ', 'ㅏ'}: 'ㅘ',
+	{'ㅗ', 'ㅐ'}: 'ㅙ',
+	{'ㅗ', 'ㅣ'}: 'ㅚ',
+	{'ㅜ', 'ㅓ'}: 'ㅝ',
+	{'ㅜ', 'ㅔ'}: 'ㅞ',
+	{'ㅜ', 'ㅣ'}: 'ㅟ',
+	{'ㅡ', 'ㅣ'}: 'ㅢ',
+}
+
+// WithCompoundVowels makes a Composer merge 2 consecutive vowels into a
+// compound vowel, such as "ㄱㅗㅏ" -> "과". By default, they are composed into
+// separate syllables, such as "ㄱㅗㅏ" -> "고아", because most specs expect a
+// hiatus.
+func WithCompoundVowels(enabled bool) Option {
+	return func(c *Composer) {
+		c.compoundVowels = enabled
+	}
+}
+
+// mergeMedial merges a vowel into the buffered medial. It reports whether
+// they have been merged.
+func (c *Composer) mergeMedial(ch rune) bool {
+	prev := c.lmt[medial]
+	if prev == 0 {
+		return false
+	}
+
+	compound, ok := compoundVowels[[2]rune{prev, ch}]
+	if !ok {
+		return false
+	}
+
+	c.lmt[medial] = compound
+	return true
+}
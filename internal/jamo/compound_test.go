@@ -0,0 +1,26 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeCompoundVowels(t *testing.T) {
+	assert.Equal(t, "고아", ComposeHangul("ㄱㅗㅏ"))
+
+	opt := WithCompoundVowels(true)
+	assert.Equal(t, "과", ComposeHangul("ㄱㅗㅏ", opt))
+	assert.Equal(t, "뭔", ComposeHangul("ㅁㅜㅓ-ㄴ", opt))
+	assert.Equal(t, "의자", ComposeHangul("ㅡㅣㅈㅏ", opt))
+	assert.Equal(t, "왜", ComposeHangul("ㅗㅐ", opt))
+
+	// On a composed syllable.
+	assert.Equal(t, "쥐", ComposeHangul("주ㅣ", opt))
+
+	// Not a compound vowel.
+	assert.Equal(t, "비에", ComposeHangul("ㅂㅣㅔ", opt))
+
+	// 3 vowels
+	assert.Equal(t, "과이", ComposeHangul("ㄱㅗㅏㅣ", opt))
+}
@@ -0,0 +1,56 @@
+package jamo
+
+// Conjoining Jamo blocks.
+const (
+	conjoiningLeadFiller   = 0x115F
+	conjoiningMedialFiller = 0x1160
+)
+
+// compatJaeums and compatMoeums map the conjoining forms of the obsolete
+// Jamo to Hangul Compatibility Jamo.
+var (
+	compatJaeums = make(map[rune]rune)
+	compatMoeums = make(map[rune]rune)
+)
+
+func init() {
+	for compat, forms := range archaicJaeums {
+		for _, form := range forms {
+			if form != 0 {
+				compatJaeums[form] = compat
+			}
+		}
+	}
+	for compat, form := range archaicMoeums {
+		compatMoeums[form] = compat
+	}
+}
+
+// isConjoiningFiller reports whether a character is a filler in conjoining
+// Jamo. The fillers stand for the missing Jamo in NFD, so they are skipped.
+func isConjoiningFiller(ch rune) bool {
+	return ch == conjoiningLeadFiller || ch == conjoiningMedialFiller
+}
+
+// fromConjoining converts a conjoining Jamo into Hangul Compatibility Jamo.
+// isTail reports whether it is a tail. ok is false if ch is not a conjoining
+// Jamo or it doesn't have a compatibility form.
+func fromConjoining(ch rune) (compat rune, isTail, ok bool) {
+	switch {
+	case ch < 0x1100 || 0x11FF < ch:
+		return 0, false, false
+	case 0x11A8 <= ch:
+		isTail = true
+	}
+
+	if compat = compatJamo(ch); compat != 0 {
+		return compat, isTail, true
+	}
+	if compat, ok = compatJaeums[ch]; ok {
+		return compat, isTail, true
+	}
+	if compat, ok = compatMoeums[ch]; ok {
+		return compat, false, true
+	}
+	return 0, false, false
+}
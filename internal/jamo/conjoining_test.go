@@ -0,0 +1,24 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestComposeConjoining(t *testing.T) {
+	// NFD
+	assert.Equal(t, "한글", ComposeHangul(norm.NFD.String("한글")))
+	assert.Equal(t, "한글", ComposeHangul("\u1112\u1161\u11ab\u1100\u1173\u11af"))
+
+	// Mixed with compatibility Jamo.
+	assert.Equal(t, "한글", ComposeHangul("\u1112ㅏ\u11abㄱ\u1173-ㄹ"))
+
+	// Fillers
+	assert.Equal(t, "아", ComposeHangul("\u115f\u1161"))
+
+	// Obsolete Jamo
+	assert.Equal(t, "\u1112\u119e\u11ab", ComposeHangul("\u1112\u119e\u11ab"))
+	assert.Equal(t, "\u1140\u1175", ComposeHangul("\u1140\u1175"))
+}
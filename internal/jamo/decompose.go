@@ -0,0 +1,55 @@
+package jamo
+
+import (
+	"strings"
+)
+
+// Decompose converts composed Hangul syllables to decomposed Jamo phonemes.
+// It is the inverse of ComposeHangul:
+//
+//	fmt.Println(jamo.Decompose("한글"))
+//	// Output: ㅎㅏ-ㄴㄱㅡ-ㄹ
+//
+// The other letters are kept as they are. But the letters which
+// ComposeHangul would interpret, such as a hyphen or a standalone Jamo, are
+// escaped with a backslash so that ComposeHangul with WithEscape restores the
+// word.
+func Decompose(word string) string {
+	var buf strings.Builder
+
+	for _, ch := range word {
+		if !isSyllable(ch) {
+			if isSpecial(ch) {
+				buf.WriteRune('\\')
+			}
+			buf.WriteRune(ch)
+			continue
+		}
+
+		l, m, t := split(ch)
+		buf.WriteRune(l)
+		buf.WriteRune(m)
+		if t != 0 {
+			buf.WriteRune('-')
+			buf.WriteRune(t)
+		}
+	}
+
+	return buf.String()
+}
+
+// isSpecial reports whether ComposeHangul interprets a character rather than
+// writing it literally.
+func isSpecial(ch rune) bool {
+	switch {
+	case ch == '-' || ch == '\\':
+		return true
+	case isCompatJaeum(ch) || isCompatMoeum(ch) || isArchaic(ch):
+		return true
+	case 0x1100 <= ch && ch <= 0x11FF:
+		return true
+	case halfwidthFiller <= ch && ch <= halfwidthMoeumLast:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompose(t *testing.T) {
+	assert.Equal(t, "ㅎㅏ-ㄴㄱㅡ-ㄹ", Decompose("한글"))
+	assert.Equal(t, "ㄲㅣ-ㅇㄲㅏ-ㅇ", Decompose("낑깡"))
+	assert.Equal(t, "ㄱㅏ-ㅄ ㅇㅏ", Decompose("값 아"))
+	assert.Equal(t, "Hangul!", Decompose("Hangul!"))
+
+	// Escaped
+	assert.Equal(t, `ㅋㅗㅋㅏ\-ㅋㅗ-ㄹㄹㅏ`, Decompose("코카-콜라"))
+	assert.Equal(t, `\ㅋ\ㅋ`, Decompose("ㅋㅋ"))
+	assert.Equal(t, `C:\\`, Decompose(`C:\`))
+}
+
+func TestDecomposeRoundTrip(t *testing.T) {
+	for _, word := range []string{"한글라이즈", "카푸치노", "뷁 쌍괄호", "Hello, 세계", "ㅋㅋ-", "\u1112\u1161"} {
+		assert.Equal(t, word, ComposeHangul(Decompose(word), WithEscape(true)))
+	}
+}
@@ -0,0 +1,58 @@
+package jamo
+
+// Distance measures the edit distance between 2 words by Jamo. It is the
+// Levenshtein distance between the decomposed Jamo, so "간" and "감" are
+// closer than "간" and "감자":
+//
+//	jamo.Distance("간", "감")  // 1
+//	jamo.Distance("간", "갓")  // 1
+//	jamo.Distance("간", "건")  // 1
+//	jamo.Distance("간", "감자") // 3
+//
+// A tail is distinguished from the same consonant as a lead. The other
+// letters are compared as they are.
+func Distance(a, b string) int {
+	x, y := jamoRunes(a), jamoRunes(b)
+
+	// The distances from x[:i] to y[:j] for the previous and the current i.
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		cur[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(y)]
+}
+
+// jamoRunes decomposes a word into Jamo. A tail is in the conjoining form to
+// be distinguished from a lead.
+func jamoRunes(word string) []rune {
+	var runes []rune
+
+	for _, ch := range word {
+		if !isSyllable(ch) {
+			runes = append(runes, ch)
+			continue
+		}
+
+		l, m, t := split(ch)
+		runes = append(runes, l, m)
+		if t != 0 {
+			runes = append(runes, tailForm(t))
+		}
+	}
+
+	return runes
+}
@@ -0,0 +1,28 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	for _, c := range []struct {
+		a, b     string
+		distance int
+	}{
+		{"간", "간", 0},
+		{"간", "감", 1},
+		{"간", "갓", 1},
+		{"간", "건", 1},
+		{"간", "가", 1},
+		{"간", "감자", 3},
+		{"간", "가나", 2},
+		{"카푸치노", "카푸치너", 1},
+		{"", "한글", 6},
+		{"Roma", "로마", 4},
+	} {
+		assert.Equal(t, c.distance, Distance(c.a, c.b), "%s %s", c.a, c.b)
+		assert.Equal(t, c.distance, Distance(c.b, c.a), "%s %s", c.b, c.a)
+	}
+}
@@ -0,0 +1,42 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeEscape(t *testing.T) {
+	escape := WithEscape(true)
+
+	assert.Equal(t, "코카-콜라", ComposeHangul(`ㅋㅗㅋㅏ\-ㅋㅗ-ㄹㄹㅏ`, escape))
+	assert.Equal(t, "칸-", ComposeHangul(`ㅋㅏ-ㄴ\-`, escape))
+	assert.Equal(t, `카\콜라`, ComposeHangul(`ㅋㅏ\\ㅋㅗ-ㄹㄹㅏ`, escape))
+	assert.Equal(t, "가ㄴ", ComposeHangul(`ㄱㅏ\ㄴ`, escape))
+
+	// A backslash which escapes nothing is literal.
+	assert.Equal(t, `a\b`, ComposeHangul(`a\b`, escape))
+	assert.Equal(t, `가\ 나`, ComposeHangul(`ㄱㅏ\ ㄴㅏ`, escape))
+
+	// A backslash at the end.
+	assert.Equal(t, `가\`, ComposeHangul(`ㄱㅏ\`, escape))
+
+	// An escaped hyphen is not a tail marker.
+	_, err := ComposeHangulStrict(`ㄱㅏ\-`, escape)
+	assert.NoError(t, err)
+
+	// An escaped tail marker.
+	assert.Equal(t, "가|-", ComposeHangul(`ㄱㅏ\|-`, escape, WithTailMarker('|')))
+}
+
+func TestComposeNoEscape(t *testing.T) {
+	// Without WithEscape, a backslash is an ordinary character.
+	assert.Equal(t, `a\b`, ComposeHangul(`a\b`))
+	assert.Equal(t, `가\나`, ComposeHangul(`ㄱㅏ\ㄴㅏ`))
+	assert.Equal(t, `칸\`, ComposeHangul(`ㅋㅏ-ㄴ\`))
+	assert.Equal(t, `코카\콜라`, ComposeHangul(`ㅋㅗㅋㅏ\ㅋㅗ-ㄹㄹㅏ`))
+	assert.Equal(t, `가\`, string(AppendComposeHangul(nil, `ㄱㅏ\`)))
+
+	_, err := ComposeHangulStrict(`ㄱㅏ\-`)
+	assert.Error(t, err)
+}
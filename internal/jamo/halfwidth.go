@@ -0,0 +1,31 @@
+package jamo
+
+// Halfwidth Jamo block.
+const (
+	halfwidthFiller       = 0xFFA0
+	halfwidthJaeumFirst   = 0xFFA1
+	halfwidthJaeumLast    = 0xFFBE
+	halfwidthMoeumFirst   = 0xFFC2
+	halfwidthMoeumLast    = 0xFFDC
+	halfwidthMoeumsPerRow = 6
+)
+
+// fromHalfwidth converts a Halfwidth Hangul Jamo into Hangul Compatibility
+// Jamo. ok is false if ch is not a Halfwidth Jamo.
+//
+// The Halfwidth Moeums are laid out in rows of 6 with 2 unassigned code
+// points between the rows, such as U+FFC8 and U+FFC9.
+func fromHalfwidth(ch rune) (compat rune, ok bool) {
+	switch {
+	case halfwidthJaeumFirst <= ch && ch <= halfwidthJaeumLast:
+		return jaeumFirst + ch - halfwidthJaeumFirst, true
+	case halfwidthMoeumFirst <= ch && ch <= halfwidthMoeumLast:
+		i := int(ch - halfwidthMoeumFirst)
+		row, col := i/(halfwidthMoeumsPerRow+2), i%(halfwidthMoeumsPerRow+2)
+		if col >= halfwidthMoeumsPerRow {
+			return 0, false
+		}
+		return moeumFirst + rune(row*halfwidthMoeumsPerRow+col), true
+	}
+	return 0, false
+}
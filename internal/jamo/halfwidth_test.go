@@ -0,0 +1,38 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHalfwidth(t *testing.T) {
+	for halfwidth, compat := range map[rune]rune{
+		'ﾡ': 'ㄱ',
+		'ﾾ': 'ㅎ',
+		'ￂ': 'ㅏ',
+		'ￇ': 'ㅔ',
+		'ￊ': 'ㅕ',
+		'ￒ': 'ㅛ',
+		'ￚ': 'ㅡ',
+		'ￜ': 'ㅣ',
+	} {
+		ch, ok := fromHalfwidth(halfwidth)
+		assert.True(t, ok)
+		assert.Equal(t, string(compat), string(ch))
+	}
+
+	// Unassigned and the others.
+	for _, ch := range []rune{'￈', '￙', 'ﾠ', 'ㄱ', 'a'} {
+		_, ok := fromHalfwidth(ch)
+		assert.False(t, ok)
+	}
+}
+
+func TestComposeHalfwidth(t *testing.T) {
+	// "ㅎㅏ-ㄴㄱㅡ-ㄹ" in Halfwidth Jamo.
+	assert.Equal(t, "한글", ComposeHangul("ﾾￂ-ﾤﾡￚ-ﾩ"))
+
+	// Mixed with compatibility Jamo and the filler.
+	assert.Equal(t, "아이", ComposeHangul("ﾠￂㅇￜ"))
+}
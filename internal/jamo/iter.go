@@ -0,0 +1,42 @@
+package jamo
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// Iter composes decomposed Jamo phonemes like ComposeHangul but yields the
+// letters lazily as soon as each of them is completed. Stopping the iteration
+// early stops the composition.
+//
+//	for letter := range jamo.Iter("ㅎㅏ-ㄴㄱㅡ-ㄹ") {
+//		fmt.Print(string(letter))
+//	}
+//	// Output: 한글
+func Iter(word string, opts ...Option) iter.Seq[rune] {
+	return func(yield func(rune) bool) {
+		c := NewComposer(opts...)
+
+		// flush yields the letters written so far.
+		flush := func() bool {
+			for i := 0; i < len(c.out); {
+				letter, size := utf8.DecodeRune(c.out[i:])
+				if !yield(letter) {
+					return false
+				}
+				i += size
+			}
+			c.out = c.out[:0]
+			return true
+		}
+
+		for _, ch := range word {
+			c.feed(ch)
+			if !flush() {
+				return
+			}
+		}
+		c.close()
+		flush()
+	}
+}
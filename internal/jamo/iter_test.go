@@ -0,0 +1,31 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIter(t *testing.T) {
+	var letters []rune
+	for letter := range Iter("ㅎㅏ-ㄴㄱㅡ-ㄹ ㄹㅏㅇㅣㅈㅡ") {
+		letters = append(letters, letter)
+	}
+	assert.Equal(t, []rune("한글 라이즈"), letters)
+
+	// With options
+	letters = nil
+	for letter := range Iter("ㄱㅗㅏ", WithCompoundVowels(true)) {
+		letters = append(letters, letter)
+	}
+	assert.Equal(t, []rune("과"), letters)
+}
+
+func TestIterStop(t *testing.T) {
+	var letters []rune
+	for letter := range Iter("ㅎㅏ-ㄴㄱㅡ-ㄹ") {
+		letters = append(letters, letter)
+		break
+	}
+	assert.Equal(t, []rune("한"), letters)
+}
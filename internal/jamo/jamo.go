@@ -4,27 +4,53 @@ to composed Hangul syllables.
 
 	fmt.Println(jamo.ComposeHangul("ㅈㅏㅁㅗ"))
 	// Output: 자모
+
+The obsolete Jamo, such as "ㆍ" or "ㅿ", are supported as well. A letter with
+them is composed into a sequence of conjoining Jamo because there are no
+precomposed syllables for it.
 */
 package jamo
 
 import (
-	"bufio"
-	"bytes"
-	"strings"
-
-	hangul "github.com/suapapa/go_hangul"
+	"slices"
+	"unicode/utf8"
 )
 
+// AppendComposeHangul appends the composed Hangul syllables of decomposed
+// Jamo phonemes to dst and returns the extended buffer like ComposeHangul. It
+// doesn't allocate if dst has enough capacity.
+func AppendComposeHangul(dst []byte, word string, opts ...Option) []byte {
+	if len(opts) != 0 {
+		c := NewComposer(opts...)
+		c.out = dst
+		return c.appendCompose(word)
+	}
+
+	// A Composer without options doesn't escape.
+	c := Composer{out: dst}
+	return c.appendCompose(word)
+}
+
 // ComposeHangul converts decomposed Jamo phonemes to composed Hangul
-// syllables.
+// syllables. It is safe for concurrent use because each call composes with
+// its own Composer.
 //
 // Decomposed Jamo phonemes look like "ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ". A Jaeum
-// after a hyphen ("-ㄴ") means that it is a Jongseong (tail).
-func ComposeHangul(word string) string {
-	c := composer{
-		r: bufio.NewReader(strings.NewReader(word)),
+// after a hyphen ("-ㄴ") means that it is a Jongseong (tail). Conjoining Jamo
+// (U+1100-U+11FF) are accepted as well, such as Hangul in NFD. A conjoining
+// tail is a tail without a hyphen. Halfwidth Jamo (U+FFA0-U+FFDC) from the
+// legacy sources are treated as Hangul Compatibility Jamo.
+//
+// With WithEscape, a backslash escapes a hyphen or a backslash, such as
+// "ㅋㅗㅋㅏ\-ㅋㅗ-ㄹㄹㅏ" -> "코카-콜라".
+func ComposeHangul(word string, opts ...Option) string {
+	if len(opts) != 0 {
+		return NewComposer(opts...).Compose(word)
 	}
-	return c.Compose()
+
+	// A Composer without options doesn't escape.
+	var c Composer
+	return c.Compose(word)
 }
 
 const (
@@ -33,150 +59,356 @@ const (
 	tail   = 2
 )
 
-// composer is a state machine which converts decomposed Jamo phonemes to
-// composed Hangul syllables.
-type composer struct {
-	r   *bufio.Reader
-	buf bytes.Buffer // The output buffer.
-	lmt [3]rune      // Buffered Jamos. [lead, medial. tail]
+// Composer is a state machine which converts decomposed Jamo phonemes to
+// composed Hangul syllables. It is fed character by character. The composed
+// letters are written to the output buffer as soon as they are completed.
+//
+// A Composer can be reused to avoid allocations, for example with
+// GetComposer and PutComposer.
+//
+// A Composer is not safe for concurrent use. Use a Composer per goroutine or
+// ComposeHangul which is safe for concurrent use.
+type Composer struct {
+	out []byte  // The output buffer.
+	lmt [3]rune // Buffered Jamos. [lead, medial. tail]
+
+	// score is the position of the last buffered Jamo in lmt.
+	score int
+
+	// isTail is set after a hyphen, the prefix of a tail Jaeum.
+	isTail bool
+
+	// tailMarker is the prefix of a tail instead of a hyphen if it is not 0.
+	tailMarker rune
+
+	// escape enables the backslash escape. isEscaped is set after a
+	// backslash.
+	escape    bool
+	isEscaped bool
+
+	// fillers are the lead and medial filled in a letter without them.
+	fillers       [2]rune
+	customFillers [2]bool
+
+	// offset is the byte offset of the next character. offsets are the
+	// offsets of the buffered Jamos and the last hyphen.
+	offset       int
+	offsets      [3]int
+	hyphenOffset int
+
+	// compoundVowels merges 2 consecutive vowels into a compound vowel.
+	compoundVowels bool
+
+	// marks are the combining marks deferred until the buffered letter is
+	// written. stripMarks drops them instead.
+	marks      []byte
+	stripMarks bool
+
+	// noSmartAttach disables merging a buffered lead with a following
+	// syllable with the vowel carrier "ㅇ".
+	noSmartAttach bool
+
+	// nfd writes the letters in conjoining Jamo instead of precomposed
+	// syllables.
+	nfd bool
+
+	// strict records the first invalid transition in err. validate collects
+	// all of them in issues.
+	strict   bool
+	err      *InvalidError
+	validate bool
+	issues   []Issue
+
+	// trace is called with each step if it is set. cursor is the offset of
+	// the character being fed.
+	trace  func(ComposeEvent)
+	cursor int
 }
 
-// read consumes 1 character. If the character is a tail Jamo, the second bool
-// return value will be set as true.
-func (c *composer) read() (rune, bool, error) {
-	isTail := false
-
-	for {
-		ch, _, err := c.r.ReadRune()
+// Compose converts decomposed Jamo phonemes to composed Hangul syllables
+// like ComposeHangul. The Composer is reset first so that the output buffer
+// is reused.
+func (c *Composer) Compose(word string) string {
+	c.Reset()
+	return string(c.appendCompose(word))
+}
 
-		if err != nil {
-			return 0, false, err
-		}
+// appendCompose composes a word into the output buffer. The output is
+// usually not longer than the word, so the buffer grows at most once.
+func (c *Composer) appendCompose(word string) []byte {
+	c.out = slices.Grow(c.out, len(word))
+	for _, ch := range word {
+		c.feed(ch)
+	}
+	c.close()
+	return c.out
+}
 
-		// Hyphen is the prefix of a tail Jaeum.
-		// Perhaps the next ch is a Jaeum.
-		if ch == '-' {
-			isTail = true
-			continue
-		}
+// Reset discards the state and the output of the Composer. The options are
+// kept.
+func (c *Composer) Reset() {
+	c.out = c.out[:0]
+	c.lmt = [3]rune{}
+	c.score = 0
+	c.isTail = false
+	c.isEscaped = false
+	c.marks = c.marks[:0]
+	c.offset = 0
+	c.err = nil
+	c.issues = nil
+}
 
-		return ch, isTail, nil
-	}
+// writeRune appends a character to the output buffer.
+func (c *Composer) writeRune(ch rune) {
+	c.out = utf8.AppendRune(c.out, ch)
 }
 
 // write writes a composed Hangul from the buffered Jamos into the output
 // buffer.
-func (c *composer) write() {
+func (c *Composer) write() {
 	if c.lmt == [3]rune{} {
 		return
 	}
 
+	if c.trace != nil {
+		n := len(c.out)
+		defer func() {
+			c.trace(ComposeEvent{Kind: FlushEvent, Offset: c.cursor, Text: string(c.out[n:])})
+		}()
+	}
+
 	// Fill missing Jamo.
-	if c.lmt[lead] == 0 {
-		c.lmt[lead] = 'ㅇ'
+	for _, pos := range [2]int{lead, medial} {
+		if c.lmt[pos] != 0 {
+			continue
+		}
+
+		filler := c.filler(pos)
+		if filler == 0 {
+			// Write the Jamo as they are without a filler.
+			for _, ch := range c.lmt {
+				if ch != 0 {
+					c.writeRune(ch)
+				}
+			}
+			c.lmt = [3]rune{}
+			c.writeMarks()
+			return
+		}
+		c.lmt[pos] = filler
 	}
-	if c.lmt[medial] == 0 {
-		c.lmt[medial] = 'ㅡ'
+
+	// Complete a letter. A letter with obsolete Jamo can't be precomposed.
+	archaic := isArchaic(c.lmt[lead]) || isArchaic(c.lmt[medial]) || isArchaic(c.lmt[tail])
+	if !archaic {
+		if leadIndex(c.lmt[lead]) < 0 {
+			c.invalid(c.offsets[lead], c.lmt[lead], "not a lead")
+		}
+		if tailIndex(c.lmt[tail]) < 0 {
+			c.invalid(c.offsets[tail], c.lmt[tail], "not a tail")
+		}
 	}
 
-	// Complete a letter.
-	letter := hangul.Join(c.lmt[lead], c.lmt[medial], c.lmt[tail])
-	c.buf.WriteRune(letter)
+	if archaic || c.nfd {
+		c.writeConjoining(c.lmt[lead], c.lmt[medial], c.lmt[tail])
+	} else {
+		letter := join(c.lmt[lead], c.lmt[medial], c.lmt[tail])
+		c.writeRune(letter)
+	}
 
 	// Clear.
 	c.lmt = [3]rune{}
+	c.writeMarks()
 }
 
-// Compose converts decomposed Jamo phonemes to composed Hangul syllables.
-func (c *composer) Compose() string {
-	var (
-		ch     rune
-		isTail bool
-		err    error
-	)
+// feed consumes 1 character.
+func (c *Composer) feed(ch rune) {
+	offset := c.offset
+	c.offset += utf8.RuneLen(ch)
+	c.cursor = offset
+
+	// An escaped character is written literally. A backslash which escapes
+	// nothing is literal as well.
+	if c.isEscaped {
+		c.isEscaped = false
+		if c.escapable(ch) {
+			c.isTail = false
+			c.write()
+			c.writeRune(ch)
+			return
+		}
+		c.writeBackslash()
+	}
+	if c.escape && ch == '\\' {
+		c.isEscaped = true
+		return
+	}
 
-	var isHangul, isMoeum, isComposed bool
+	// Hyphen, or the tail marker, is the prefix of a tail Jaeum.
+	// Perhaps the next ch is a Jaeum.
+	if ch == c.marker() {
+		if c.isTail {
+			c.invalid(offset, ch, "repeated hyphen")
+		}
+		c.isTail = true
+		c.hyphenOffset = offset
+		return
+	}
 
-	// Score values can be -1 for non-Hangul,
-	// 0 for leads, 1 for medials, and 2 for tails.
-	var score, prevScore int
+	// Most characters are Hangul Compatibility Jamo which don't need to be
+	// normalized.
+	if !isCompatJaeum(ch) && !isCompatMoeum(ch) {
+		var ok bool
+		if ch, ok = c.normalize(ch, offset); !ok {
+			return
+		}
+	}
 
-	for {
-		prevScore = score
+	isTail := c.isTail
+	c.isTail = false
 
-		ch, isTail, err = c.read()
+	isHangul, isJaeum, isMoeum, isComposed := analyzeHangul(ch)
 
-		if err != nil {
-			break
-		}
+	if isTail && !isJaeum {
+		c.invalid(c.hyphenOffset, c.marker(), "hyphen not followed by a Jaeum")
+	}
 
-		isHangul, _, isMoeum, isComposed = analyzeHangul(ch)
+	// Non-Hangul
+	if !isHangul {
+		c.write()
+		c.writeRune(ch)
+		return
+	}
 
-		// Non-Hangul
-		if !isHangul {
-			if prevScore != -1 {
-				c.write()
-			}
+	// Composed Hangul
+	if isComposed {
+		if c.attach(ch) {
+			c.traceMerge(ch)
+			return
+		}
+		c.write()
 
-			c.buf.WriteRune(ch)
-			prevScore = -1
+		// Decompose it to merge with a tail later.
+		c.lmt[lead], c.lmt[medial], c.lmt[tail] = split(ch)
 
-			continue
+		if c.lmt[tail] == 0 {
+			c.score = medial
+		} else {
+			c.score = tail
 		}
+		c.traceBuffer(ch)
+		return
+	}
 
-		// Composed Hangul
-		if isComposed {
-			c.write()
+	// Decomposed Jamo
+	var score int
+	if isMoeum {
+		score = medial
+	} else if isTail {
+		score = tail
+	} else {
+		score = lead
+	}
 
-			// Decompose it to merge with a tail later.
-			c.lmt[lead], c.lmt[medial], c.lmt[tail] = hangul.Split(ch)
+	// 2 consecutive vowels may be a compound vowel.
+	if score == medial && c.score == medial && c.compoundVowels && c.mergeMedial(ch) {
+		c.traceMerge(ch)
+		return
+	}
 
-			if c.lmt[tail] == 0 {
-				score = medial
-			} else {
-				score = tail
-			}
+	// 2 consecutive tails may be a cluster.
+	if score == tail && c.score == tail && c.mergeTail(ch) {
+		c.traceMerge(ch)
+		return
+	}
 
-			continue
-		}
+	// If cursor should be moved forward, flush the buffered letter.
+	if score <= c.score {
+		c.write()
+	}
 
-		// Decomposed Jamo
-		if isMoeum {
-			score = medial
-		} else if isTail {
-			score = tail
-		} else {
-			score = lead
-		}
+	if score == tail && c.lmt == [3]rune{} {
+		c.invalid(offset, ch, "tail without a syllable")
+	}
 
-		// If cursor should be moved forward, flush the buffered letter.
-		if score <= prevScore {
-			c.write()
-		}
+	// Buffer the Jamo.
+	c.lmt[score] = ch
+	c.offsets[score] = offset
+	c.score = score
+	c.traceBuffer(ch)
+}
 
-		// Buffer the Jamo.
-		if score != -1 {
-			c.lmt[score] = ch
+// normalize converts conjoining and Halfwidth Jamo into Hangul Compatibility
+// Jamo. A conjoining tail is a tail without a hyphen. It reports false if the
+// character has been consumed, such as a filler or a combining mark.
+func (c *Composer) normalize(ch rune, offset int) (rune, bool) {
+	// A combining mark doesn't break the buffered letter.
+	if isMark(ch) && c.deferMark(ch) {
+		return 0, false
+	}
+
+	if isConjoiningFiller(ch) || ch == halfwidthFiller {
+		return 0, false
+	}
+	if compat, ok := fromHalfwidth(ch); ok {
+		return compat, true
+	}
+	if compat, isTail, ok := fromConjoining(ch); ok {
+		if isTail && !c.isTail {
+			c.isTail = true
+			c.hyphenOffset = offset
 		}
+		return compat, true
 	}
+	return ch, true
+}
 
-	// Write the final letter.
-	if prevScore != -1 {
-		c.write()
+// escapable reports whether a backslash escapes a character. They are the
+// tail marker, a backslash, and a standalone Jamo which Decompose escapes.
+func (c *Composer) escapable(ch rune) bool {
+	return ch == c.marker() || isSpecial(ch)
+}
+
+// writeBackslash writes a backslash which escapes nothing as a non-Hangul
+// character.
+func (c *Composer) writeBackslash() {
+	if c.isTail {
+		c.invalid(c.hyphenOffset, c.marker(), "hyphen not followed by a Jaeum")
+		c.isTail = false
 	}
+	c.write()
+	c.writeRune('\\')
+}
 
-	return c.buf.String()
+// close writes the final letter and resets the state except the output
+// buffer.
+func (c *Composer) close() {
+	c.cursor = c.offset
+	if c.isEscaped {
+		c.writeBackslash()
+		c.isEscaped = false
+	}
+	if c.isTail {
+		c.invalid(c.hyphenOffset, c.marker(), "hyphen not followed by a Jaeum")
+	}
+	c.write()
+	c.score = 0
+	c.isTail = false
 }
 
 // analyzeHangul analyzes a Hangul character to check if it is a Jaeum, a
 // Moeum, or a composed Hangul.
 func analyzeHangul(ch rune) (isHangul, isJaeum, isMoeum, isComposed bool) {
-	isHangul = hangul.IsHangul(ch)
-	if !isHangul {
-		return
+	switch {
+	case isArchaicJaeum(ch):
+		return true, true, false, false
+	case isArchaicMoeum(ch):
+		return true, false, true, false
 	}
-	isJaeum = hangul.IsJaeum(ch)
-	isMoeum = hangul.IsMoeum(ch)
-	isComposed = !isJaeum && !isMoeum
+
+	isJaeum = isCompatJaeum(ch)
+	isMoeum = isCompatMoeum(ch)
+	isComposed = isSyllable(ch)
+	isHangul = isJaeum || isMoeum || isComposed
 	return
 }
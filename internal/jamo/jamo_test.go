@@ -2,6 +2,7 @@ package jamo
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,18 @@ func TestComposeHangulNonHangul(t *testing.T) {
 	assert.Equal(t, "안녕, world", ComposeHangul("ㅇㅏ-ㄴㄴㅕ-ㅇ, world"))
 }
 
+func TestComposeHangulAllocs(t *testing.T) {
+	word := strings.Repeat("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ ", 20)
+
+	if raceEnabled {
+		t.Skip("the race detector makes more allocations")
+	}
+
+	// The output buffer and the string.
+	allocs := testing.AllocsPerRun(100, func() { ComposeHangul(word) })
+	assert.Equal(t, 2.0, allocs)
+}
+
 // -----------------------------------------------------------------------------
 // Benchmarks
 
@@ -31,6 +44,14 @@ func BenchmarkComposeHangul(b *testing.B) {
 	}
 }
 
+func BenchmarkComposeHangulLong(b *testing.B) {
+	word := strings.Repeat("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ ", 20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ComposeHangul(word)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Examples
 
@@ -43,3 +64,36 @@ func ExampleComposeHangul_interpolation() {
 	fmt.Println(ComposeHangul("ㅗㅈ"))
 	// Output: 오즈
 }
+
+func TestComposerReset(t *testing.T) {
+	var c Composer
+	assert.Equal(t, "한글", c.Compose("ㅎㅏ-ㄴㄱㅡ-ㄹ"))
+
+	// An unfinished state doesn't leak into the next word.
+	c.feed('ㄱ')
+	c.Reset()
+	assert.Equal(t, "자모", c.Compose("ㅈㅏㅁㅗ"))
+
+	if raceEnabled {
+		return
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		c.Compose("ㅎㅏ-ㄴㄱㅡ-ㄹ")
+	})
+	assert.Equal(t, 1.0, allocs)
+}
+
+func TestAppendComposeHangul(t *testing.T) {
+	buf := []byte("Hangulize: ")
+	buf = AppendComposeHangul(buf, "ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ")
+	assert.Equal(t, "Hangulize: 한글라이즈", string(buf))
+
+	if raceEnabled {
+		return
+	}
+	buf = make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		AppendComposeHangul(buf[:0], "ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ")
+	})
+	assert.Equal(t, 0.0, allocs)
+}
@@ -0,0 +1,45 @@
+package jamo
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// WithStripMarks makes a Composer drop the combining marks, such as U+0301,
+// in the input. By default, a combining mark among the buffered Jamo is
+// deferred after the letter, such as U+0301 in "ㄱ", U+0301, "ㅏ" which is
+// written after "가".
+func WithStripMarks(enabled bool) Option {
+	return func(c *Composer) {
+		c.stripMarks = enabled
+	}
+}
+
+// isMark reports whether a character is a combining mark which a
+// transliteration may leave among Jamo.
+func isMark(ch rune) bool {
+	// Skip the table lookup for the most common letters.
+	if ch < 0x0300 || isCompatJaeum(ch) || isCompatMoeum(ch) || isSyllable(ch) {
+		return false
+	}
+	return unicode.Is(unicode.Mn, ch)
+}
+
+// deferMark keeps a combining mark until the buffered letter is written. It
+// reports whether the mark has been consumed.
+func (c *Composer) deferMark(ch rune) bool {
+	if c.stripMarks {
+		return true
+	}
+	if c.lmt == [3]rune{} {
+		return false
+	}
+	c.marks = utf8.AppendRune(c.marks, ch)
+	return true
+}
+
+// writeMarks writes the deferred combining marks.
+func (c *Composer) writeMarks() {
+	c.out = append(c.out, c.marks...)
+	c.marks = c.marks[:0]
+}
@@ -0,0 +1,23 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeMarks(t *testing.T) {
+	// Deferred after the letter.
+	assert.Equal(t, "가\u0301", ComposeHangul("ㄱ\u0301ㅏ"))
+	assert.Equal(t, "간\u0301\u0308", ComposeHangul("ㄱㅏ-\u0301ㄴ\u0308"))
+	assert.Equal(t, "바\u0300흐", ComposeHangul("ㅂㅏ\u0300ㅎ"))
+
+	// Not among Jamo.
+	assert.Equal(t, "e\u0301가", ComposeHangul("e\u0301ㄱㅏ"))
+	assert.Equal(t, "\u0301가", ComposeHangul("\u0301ㄱㅏ"))
+}
+
+func TestStripMarks(t *testing.T) {
+	assert.Equal(t, "가", ComposeHangul("ㄱ\u0301ㅏ", WithStripMarks(true)))
+	assert.Equal(t, "e가", ComposeHangul("e\u0301ㄱㅏ", WithStripMarks(true)))
+}
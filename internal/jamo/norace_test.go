@@ -0,0 +1,6 @@
+//go:build !race
+
+package jamo
+
+// raceEnabled is set with the race detector which makes more allocations.
+const raceEnabled = false
@@ -0,0 +1,81 @@
+package jamo
+
+// Option customizes a Composer.
+type Option func(*Composer)
+
+// defaultFillers are the Jamo filled in a letter without a lead or a medial.
+var defaultFillers = [2]rune{lead: 'ㅇ', medial: 'ㅡ'}
+
+// WithLeadFiller sets the Jamo filled in a letter without a lead. It is "ㅇ"
+// by default. If it is 0, the Jamo of such a letter are written as they are.
+func WithLeadFiller(ch rune) Option {
+	return func(c *Composer) {
+		c.fillers[lead] = ch
+		c.customFillers[lead] = true
+	}
+}
+
+// WithMedialFiller sets the Jamo filled in a letter without a medial, such
+// as "ㅜ" for "ㅂㅏ-ㅎ" -> "바후". It is "ㅡ" by default. If it is 0, the Jamo
+// of such a letter are written as they are.
+func WithMedialFiller(ch rune) Option {
+	return func(c *Composer) {
+		c.fillers[medial] = ch
+		c.customFillers[medial] = true
+	}
+}
+
+// WithNFD makes a Composer write the letters in conjoining Jamo as Hangul in
+// NFD, such as "\u1112\u1161\u11ab" for "한", instead of precomposed
+// syllables. Some systems require NFD, such as the file names on older macOS.
+func WithNFD(enabled bool) Option {
+	return func(c *Composer) {
+		c.nfd = enabled
+	}
+}
+
+// WithTailMarker sets the prefix of a tail Jaeum instead of a hyphen, such as
+// "ㅎㅏ|ㄴ" with '|'. Then a hyphen is an ordinary character. It is useful if
+// the input already uses hyphens for other purposes, such as morphological
+// boundaries.
+func WithTailMarker(ch rune) Option {
+	return func(c *Composer) {
+		c.tailMarker = ch
+	}
+}
+
+// WithEscape makes a backslash escape the next character, so "\-" is a
+// literal hyphen and "\\" is a literal backslash. A standalone Jamo which
+// Decompose escapes, such as "\ㅋ", is written as it is too. Any other
+// backslash is literal. Without it, a backslash is an ordinary character.
+func WithEscape(enabled bool) Option {
+	return func(c *Composer) {
+		c.escape = enabled
+	}
+}
+
+// NewComposer creates a Composer with the options. The zero value of
+// Composer is a Composer without options.
+func NewComposer(opts ...Option) *Composer {
+	c := &Composer{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// filler returns the Jamo filled at the position.
+func (c *Composer) filler(pos int) rune {
+	if c.customFillers[pos] {
+		return c.fillers[pos]
+	}
+	return defaultFillers[pos]
+}
+
+// marker returns the prefix of a tail Jaeum.
+func (c *Composer) marker() rune {
+	if c.tailMarker != 0 {
+		return c.tailMarker
+	}
+	return '-'
+}
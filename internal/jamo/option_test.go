@@ -0,0 +1,43 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestFillers(t *testing.T) {
+	assert.Equal(t, "바흐", ComposeHangul("ㅂㅏㅎ"))
+	assert.Equal(t, "바후", ComposeHangul("ㅂㅏㅎ", WithMedialFiller('ㅜ')))
+	assert.Equal(t, "아이", ComposeHangul("ㅏㅣ"))
+	assert.Equal(t, "하히", ComposeHangul("ㅏㅣ", WithLeadFiller('ㅎ')))
+
+	// No fillers.
+	assert.Equal(t, "바ㅎ", ComposeHangul("ㅂㅏㅎ", WithMedialFiller(0)))
+	assert.Equal(t, "ㅏㄴ이", ComposeHangul("ㅏ-ㄴㅇㅣ", WithLeadFiller(0)))
+}
+
+func TestNFD(t *testing.T) {
+	assert.Equal(t, norm.NFD.String("한글 라이즈"), ComposeHangul("ㅎㅏ-ㄴㄱㅡ-ㄹ ㄹㅏㅇㅣㅈㅡ", WithNFD(true)))
+	assert.Equal(t, "\u1112\u1161\u11ab", ComposeHangul("한", WithNFD(true)))
+
+	// Obsolete Jamo are written in conjoining Jamo anyway.
+	assert.Equal(t, "\u1112\u119e\u11ab", ComposeHangul("ㅎㆍ-ㄴ", WithNFD(true)))
+
+	// An invalid tail is dropped as well as in precomposed syllables.
+	assert.Equal(t, "\u1100\u1161", ComposeHangul("ㄱㅏ-ㄸ", WithNFD(true)))
+	assert.Equal(t, "가", ComposeHangul("ㄱㅏ-ㄸ"))
+}
+
+func TestTailMarker(t *testing.T) {
+	assert.Equal(t, "한글", ComposeHangul("ㅎㅏ|ㄴㄱㅡ|ㄹ", WithTailMarker('|')))
+	assert.Equal(t, "한글", ComposeHangul("ㅎㅏ\u200dㄴㄱㅡ\u200dㄹ", WithTailMarker('\u200d')))
+
+	// A hyphen is an ordinary character.
+	assert.Equal(t, "한-글", ComposeHangul("ㅎㅏ|ㄴ-ㄱㅡ|ㄹ", WithTailMarker('|')))
+	assert.Equal(t, "하-느", ComposeHangul("ㅎㅏ-ㄴ", WithTailMarker('|')))
+
+	_, err := ComposeHangulStrict("ㅎㅏ||ㄴ", WithTailMarker('|'))
+	assert.Equal(t, &InvalidError{Offset: 7, Char: '|', Reason: "repeated hyphen"}, err)
+}
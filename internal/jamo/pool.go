@@ -0,0 +1,33 @@
+package jamo
+
+import "sync"
+
+// composerPool keeps the Composers released by PutComposer.
+var composerPool = sync.Pool{
+	New: func() any { return new(Composer) },
+}
+
+// GetComposer takes a Composer with the options from a pool. The output
+// buffer of a released Composer is reused, so it doesn't allocate in the
+// steady state except the result strings:
+//
+//	c := jamo.GetComposer()
+//	defer jamo.PutComposer(c)
+//	c.Compose("ㅈㅏㅁㅗ")
+//
+// The Composer must not be used after PutComposer.
+func GetComposer(opts ...Option) *Composer {
+	c := composerPool.Get().(*Composer)
+
+	// Only the buffers survive.
+	*c = Composer{out: c.out[:0], marks: c.marks[:0]}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// PutComposer releases a Composer taken by GetComposer to the pool.
+func PutComposer(c *Composer) {
+	composerPool.Put(c)
+}
@@ -0,0 +1,61 @@
+package jamo
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetComposer(t *testing.T) {
+	c := GetComposer(WithCompoundVowels(true))
+	assert.Equal(t, "과", c.Compose("ㄱㅗㅏ"))
+	PutComposer(c)
+
+	// The options of a released Composer don't survive.
+	c = GetComposer()
+	assert.Equal(t, "고아", c.Compose("ㄱㅗㅏ"))
+	PutComposer(c)
+}
+
+func TestComposeHangulConcurrent(t *testing.T) {
+	word := strings.Repeat("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ ", 10)
+	expected := ComposeHangul(word)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				assert.Equal(t, expected, ComposeHangul(word))
+
+				c := GetComposer()
+				assert.Equal(t, expected, c.Compose(word))
+				PutComposer(c)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkComposeHangulParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ComposeHangul("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ")
+		}
+	})
+}
+
+func BenchmarkGetComposerParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c := GetComposer()
+			c.Compose("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ")
+			PutComposer(c)
+		}
+	})
+}
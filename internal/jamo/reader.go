@@ -0,0 +1,86 @@
+package jamo
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// readerBufSize is the size of the buffer of a Reader for the underlying
+// reader.
+const readerBufSize = 4096
+
+// Reader is a streaming ComposeHangul. It reads decomposed Jamo phonemes from
+// the underlying reader and composes them on the fly:
+//
+//	r := jamo.NewReader(jamoFile)
+//	io.Copy(os.Stdout, r)
+//
+// The final letter is composed when the underlying reader returns io.EOF.
+type Reader struct {
+	r io.Reader
+	c Composer
+
+	// buf is the buffer for the underlying reader. The first partial bytes
+	// are an incomplete UTF-8 sequence at the end of the last read.
+	buf     []byte
+	partial int
+
+	// pos is the offset of the composed letters which haven't been read yet
+	// in the output of the Composer.
+	pos int
+
+	// err is the error from the underlying reader.
+	err error
+}
+
+// NewReader creates a Reader which reads from r.
+func NewReader(r io.Reader, opts ...Option) *Reader {
+	reader := &Reader{r: r}
+	for _, opt := range opts {
+		opt(&reader.c)
+	}
+	return reader
+}
+
+// Read reads the composed Hangul syllables.
+func (r *Reader) Read(p []byte) (int, error) {
+	for r.pos == len(r.c.out) {
+		r.c.out = r.c.out[:0]
+		r.pos = 0
+
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.fill()
+	}
+
+	n := copy(p, r.c.out[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// fill reads from the underlying reader and composes it.
+func (r *Reader) fill() {
+	if r.buf == nil {
+		r.buf = make([]byte, readerBufSize)
+	}
+
+	n, err := r.r.Read(r.buf[r.partial:])
+	data := r.buf[:r.partial+n]
+
+	for len(data) != 0 && utf8.FullRune(data) {
+		ch, size := utf8.DecodeRune(data)
+		r.c.feed(ch)
+		data = data[size:]
+	}
+	r.partial = copy(r.buf, data)
+
+	if err == io.EOF {
+		for _, ch := range string(r.buf[:r.partial]) {
+			r.c.feed(ch)
+		}
+		r.partial = 0
+		r.c.close()
+	}
+	r.err = err
+}
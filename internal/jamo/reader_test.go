@@ -0,0 +1,52 @@
+package jamo
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	r := NewReader(strings.NewReader("ㅎㅏ-ㄴㄱㅡ-ㄹ ㄹㅏㅇㅣㅈㅡ"))
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "한글 라이즈", string(data))
+}
+
+func TestReaderOneByte(t *testing.T) {
+	word := strings.Repeat("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ, ", 1000)
+
+	// Split every multi-byte character in both of the input and the output.
+	r := iotest.OneByteReader(NewReader(iotest.OneByteReader(strings.NewReader(word))))
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, ComposeHangul(word), string(data))
+}
+
+func TestReaderOptions(t *testing.T) {
+	data, err := io.ReadAll(NewReader(strings.NewReader("ㄱㅗㅏ"), WithCompoundVowels(true)))
+	require.NoError(t, err)
+	assert.Equal(t, "과", string(data))
+}
+
+func TestReaderError(t *testing.T) {
+	errBroken := errors.New("broken")
+	r := NewReader(io.MultiReader(strings.NewReader("ㄱㅏㄴㅏ"), iotest.ErrReader(errBroken)))
+
+	// The completed letters are read before the error.
+	data, err := io.ReadAll(r)
+	assert.ErrorIs(t, err, errBroken)
+	assert.Equal(t, "가", string(data))
+}
+
+func TestReaderIOTest(t *testing.T) {
+	word := "ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ"
+	assert.NoError(t, iotest.TestReader(NewReader(strings.NewReader(word)), []byte(ComposeHangul(word))))
+}
@@ -0,0 +1,199 @@
+package jamo
+
+import "strings"
+
+// RomanizationSystem is a system to romanize Hangul.
+type RomanizationSystem int
+
+const (
+	// RevisedRomanization is the official system in South Korea since 2000:
+	// "한국어" -> "hangugeo".
+	RevisedRomanization RomanizationSystem = iota
+
+	// McCuneReischauer is the traditional system in the academia and North
+	// Korea: "한국어" -> "han'gugŏ".
+	McCuneReischauer
+
+	// Yale is the letter-by-letter system in linguistics: "한국어" ->
+	// "hankwuke".
+	Yale
+)
+
+// romanization is the table of a RomanizationSystem.
+type romanization struct {
+	leads   map[rune]string
+	medials map[rune]string
+	tails   map[rune]string
+}
+
+var rrVowels = map[rune]string{
+	'ㅏ': "a", 'ㅐ': "ae", 'ㅑ': "ya", 'ㅒ': "yae", 'ㅓ': "eo", 'ㅔ': "e",
+	'ㅕ': "yeo", 'ㅖ': "ye", 'ㅗ': "o", 'ㅘ': "wa", 'ㅙ': "wae", 'ㅚ': "oe",
+	'ㅛ': "yo", 'ㅜ': "u", 'ㅝ': "wo", 'ㅞ': "we", 'ㅟ': "wi", 'ㅠ': "yu",
+	'ㅡ': "eu", 'ㅢ': "ui", 'ㅣ': "i",
+}
+
+// representativeTails are the tails pronounced as the 7 representative
+// consonants.
+var representativeTails = map[rune]rune{
+	'ㄱ': 'ㄱ', 'ㄲ': 'ㄱ', 'ㄳ': 'ㄱ', 'ㄺ': 'ㄱ', 'ㅋ': 'ㄱ',
+	'ㄴ': 'ㄴ', 'ㄵ': 'ㄴ', 'ㄶ': 'ㄴ',
+	'ㄷ': 'ㄷ', 'ㅅ': 'ㄷ', 'ㅆ': 'ㄷ', 'ㅈ': 'ㄷ', 'ㅊ': 'ㄷ', 'ㅌ': 'ㄷ', 'ㅎ': 'ㄷ',
+	'ㄹ': 'ㄹ', 'ㄼ': 'ㄹ', 'ㄽ': 'ㄹ', 'ㄾ': 'ㄹ', 'ㅀ': 'ㄹ',
+	'ㅁ': 'ㅁ', 'ㄻ': 'ㅁ',
+	'ㅂ': 'ㅂ', 'ㅄ': 'ㅂ', 'ㄿ': 'ㅂ', 'ㅍ': 'ㅂ',
+	'ㅇ': 'ㅇ',
+}
+
+var romanizations = map[RomanizationSystem]romanization{
+	RevisedRomanization: {
+		leads: map[rune]string{
+			'ㄱ': "g", 'ㄲ': "kk", 'ㄴ': "n", 'ㄷ': "d", 'ㄸ': "tt", 'ㄹ': "r",
+			'ㅁ': "m", 'ㅂ': "b", 'ㅃ': "pp", 'ㅅ': "s", 'ㅆ': "ss", 'ㅇ': "",
+			'ㅈ': "j", 'ㅉ': "jj", 'ㅊ': "ch", 'ㅋ': "k", 'ㅌ': "t", 'ㅍ': "p",
+			'ㅎ': "h",
+		},
+		medials: rrVowels,
+		tails: map[rune]string{
+			'ㄱ': "k", 'ㄴ': "n", 'ㄷ': "t", 'ㄹ': "l", 'ㅁ': "m", 'ㅂ': "p",
+			'ㅇ': "ng",
+		},
+	},
+	McCuneReischauer: {
+		leads: map[rune]string{
+			'ㄱ': "k", 'ㄲ': "kk", 'ㄴ': "n", 'ㄷ': "t", 'ㄸ': "tt", 'ㄹ': "r",
+			'ㅁ': "m", 'ㅂ': "p", 'ㅃ': "pp", 'ㅅ': "s", 'ㅆ': "ss", 'ㅇ': "",
+			'ㅈ': "ch", 'ㅉ': "tch", 'ㅊ': "ch'", 'ㅋ': "k'", 'ㅌ': "t'", 'ㅍ': "p'",
+			'ㅎ': "h",
+		},
+		medials: map[rune]string{
+			'ㅏ': "a", 'ㅐ': "ae", 'ㅑ': "ya", 'ㅒ': "yae", 'ㅓ': "ŏ", 'ㅔ': "e",
+			'ㅕ': "yŏ", 'ㅖ': "ye", 'ㅗ': "o", 'ㅘ': "wa", 'ㅙ': "wae", 'ㅚ': "oe",
+			'ㅛ': "yo", 'ㅜ': "u", 'ㅝ': "wŏ", 'ㅞ': "we", 'ㅟ': "wi", 'ㅠ': "yu",
+			'ㅡ': "ŭ", 'ㅢ': "ŭi", 'ㅣ': "i",
+		},
+		tails: map[rune]string{
+			'ㄱ': "k", 'ㄴ': "n", 'ㄷ': "t", 'ㄹ': "l", 'ㅁ': "m", 'ㅂ': "p",
+			'ㅇ': "ng",
+		},
+	},
+	Yale: {
+		leads: map[rune]string{
+			'ㄱ': "k", 'ㄲ': "kk", 'ㄴ': "n", 'ㄷ': "t", 'ㄸ': "tt", 'ㄹ': "l",
+			'ㅁ': "m", 'ㅂ': "p", 'ㅃ': "pp", 'ㅅ': "s", 'ㅆ': "ss", 'ㅇ': "",
+			'ㅈ': "c", 'ㅉ': "cc", 'ㅊ': "ch", 'ㅋ': "kh", 'ㅌ': "th", 'ㅍ': "ph",
+			'ㅎ': "h",
+		},
+		medials: map[rune]string{
+			'ㅏ': "a", 'ㅐ': "ay", 'ㅑ': "ya", 'ㅒ': "yay", 'ㅓ': "e", 'ㅔ': "ey",
+			'ㅕ': "ye", 'ㅖ': "yey", 'ㅗ': "o", 'ㅘ': "wa", 'ㅙ': "way", 'ㅚ': "oy",
+			'ㅛ': "yo", 'ㅜ': "wu", 'ㅝ': "we", 'ㅞ': "wey", 'ㅟ': "wi", 'ㅠ': "yu",
+			'ㅡ': "u", 'ㅢ': "uy", 'ㅣ': "i",
+		},
+		tails: map[rune]string{
+			'ㄱ': "k", 'ㄲ': "kk", 'ㄳ': "ks", 'ㄴ': "n", 'ㄵ': "nc", 'ㄶ': "nh",
+			'ㄷ': "t", 'ㄹ': "l", 'ㄺ': "lk", 'ㄻ': "lm", 'ㄼ': "lp", 'ㄽ': "ls",
+			'ㄾ': "lth", 'ㄿ': "lph", 'ㅀ': "lh", 'ㅁ': "m", 'ㅂ': "p", 'ㅄ': "ps",
+			'ㅅ': "s", 'ㅆ': "ss", 'ㅇ': "ng", 'ㅈ': "c", 'ㅊ': "ch", 'ㅋ': "kh",
+			'ㅌ': "th", 'ㅍ': "ph", 'ㅎ': "h",
+		},
+	},
+}
+
+// Romanize converts decomposed Jamo phonemes or composed Hangul syllables
+// into the Latin alphabet by a RomanizationSystem. The other letters are
+// kept as they are.
+//
+// Revised Romanization and McCune-Reischauer transcribe the pronunciation,
+// such as the liaison of a tail before "ㅇ" and the voicing in
+// McCune-Reischauer. But the other sound changes are not applied. Yale
+// transliterates each Jamo.
+func Romanize(word string, system RomanizationSystem) string {
+	table := romanizations[system]
+	letters := []rune(ComposeHangul(word))
+
+	var buf strings.Builder
+
+	for i, letter := range letters {
+		if !isSyllable(letter) {
+			buf.WriteRune(letter)
+			continue
+		}
+
+		l, m, t := split(letter)
+
+		// The tail of the previous syllable and the lead of the next
+		// syllable. 0 means that the syllable is at the boundary of a word.
+		var prevTail, nextLead rune = 0, 0
+		prevIsSyllable := i > 0 && isSyllable(letters[i-1])
+		if prevIsSyllable {
+			_, _, prevTail = split(letters[i-1])
+		}
+		if i+1 < len(letters) && isSyllable(letters[i+1]) {
+			nextLead, _, _ = split(letters[i+1])
+		}
+
+		if system == Yale {
+			buf.WriteString(table.leads[l])
+			if (m == 'ㅜ') && (l == 'ㅁ' || l == 'ㅂ' || l == 'ㅃ' || l == 'ㅍ') {
+				// "wu" is "u" after the labials.
+				buf.WriteString("u")
+			} else {
+				buf.WriteString(table.medials[m])
+			}
+			buf.WriteString(table.tails[t])
+			continue
+		}
+
+		buf.WriteString(romanizeLead(system, table, l, m, prevTail, prevIsSyllable))
+		buf.WriteString(table.medials[m])
+
+		switch {
+		case t == 0:
+		case nextLead == 'ㅇ' && t != 'ㅇ':
+			// Liaison: the tail is pronounced as the lead of the next
+			// syllable.
+			if t != 'ㅎ' {
+				buf.WriteString(romanizeLead(system, table, t, 0, 0, true))
+			}
+		default:
+			buf.WriteString(table.tails[representativeTails[t]])
+			if system == McCuneReischauer && t == 'ㄴ' && nextLead == 'ㄱ' {
+				// "n'g" is distinguished from "ng".
+				buf.WriteString("'")
+			}
+		}
+	}
+
+	return buf.String()
+}
+
+// romanizeLead romanizes a lead in Revised Romanization or
+// McCune-Reischauer.
+func romanizeLead(system RomanizationSystem, table romanization, l, m, prevTail rune, prevIsSyllable bool) string {
+	switch {
+	case l == 'ㄹ' && (prevTail == 'ㄹ' || system == McCuneReischauer && prevTail == 'ㄴ'):
+		return "l"
+	case system != McCuneReischauer:
+		return table.leads[l]
+	case l == 'ㅅ' && m == 'ㅣ':
+		return "sh"
+	}
+
+	// The plain stops are voiced after a vowel or a voiced tail in
+	// McCune-Reischauer.
+	voiced := prevIsSyllable && (prevTail == 0 || strings.ContainsRune("ㄴㄹㅁㅇ", prevTail))
+	if voiced {
+		switch l {
+		case 'ㄱ':
+			return "g"
+		case 'ㄷ':
+			return "d"
+		case 'ㅂ':
+			return "b"
+		case 'ㅈ':
+			return "j"
+		}
+	}
+	return table.leads[l]
+}
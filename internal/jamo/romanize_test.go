@@ -0,0 +1,29 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRomanize(t *testing.T) {
+	for _, c := range []struct {
+		word       string
+		rr, mr, yl string
+	}{
+		{"한국어", "hangugeo", "han'gugŏ", "hankwuke"},
+		{"서울", "seoul", "sŏul", "sewul"},
+		{"부산", "busan", "pusan", "pusan"},
+		{"설악", "seorak", "sŏrak", "selak"},
+		{"카푸치노", "kapuchino", "k'ap'uch'ino", "khaphuchino"},
+		{"시장", "sijang", "shijang", "sicang"},
+		{"값", "gap", "kap", "kaps"},
+	} {
+		assert.Equal(t, c.rr, Romanize(c.word, RevisedRomanization), c.word)
+		assert.Equal(t, c.mr, Romanize(c.word, McCuneReischauer), c.word)
+		assert.Equal(t, c.yl, Romanize(c.word, Yale), c.word)
+	}
+
+	// Decomposed Jamo phonemes
+	assert.Equal(t, "hangeul raijeu!", Romanize("ㅎㅏ-ㄴㄱㅡ-ㄹ ㄹㅏㅇㅣㅈㅡ!", RevisedRomanization))
+}
@@ -0,0 +1,65 @@
+package jamo
+
+import "fmt"
+
+// InvalidError is an invalid Jamo transition which ComposeHangul swallows
+// silently.
+type InvalidError struct {
+	// Offset is the byte offset of the invalid character in the input.
+	Offset int
+	Char   rune
+	Reason string
+}
+
+func (e *InvalidError) Error() string {
+	return fmt.Sprintf("jamo: %s: %q at %d", e.Reason, e.Char, e.Offset)
+}
+
+// ComposeHangulStrict is ComposeHangul which reports the first invalid Jamo
+// transition as an *InvalidError:
+//
+//   - a hyphen which is not followed by a Jaeum, such as "--ㄴ" or "-ㅏ"
+//   - a tail without a syllable to attach to, such as "-ㄴ" at the start
+//   - a Jaeum which can't be at the position, such as "-ㄸ"
+//
+// The result is composed as ComposeHangul even if there is an error.
+func ComposeHangulStrict(word string, opts ...Option) (string, error) {
+	c := NewComposer(opts...)
+	c.strict = true
+
+	result := c.Compose(word)
+	if c.err != nil {
+		return result, c.err
+	}
+	return result, nil
+}
+
+// Issue is an invalid Jamo transition reported by Validate.
+type Issue struct {
+	// Offset is the byte offset of the invalid character in the input.
+	Offset int
+	Char   rune
+	Desc   string
+}
+
+// Validate reports all the invalid Jamo transitions in decomposed Jamo
+// phonemes, which ComposeHangulStrict reports only the first of. It returns
+// nil if the word can be composed cleanly. It is useful to lint the specs.
+func Validate(word string, opts ...Option) []Issue {
+	c := NewComposer(opts...)
+	c.validate = true
+
+	c.Compose(word)
+	return c.issues
+}
+
+// invalid records an invalid transition if the Composer is strict. Only the
+// first one is recorded. All of them are collected for Validate.
+func (c *Composer) invalid(offset int, ch rune, reason string) {
+	if c.validate {
+		c.issues = append(c.issues, Issue{offset, ch, reason})
+	}
+	if c.strict && c.err == nil {
+		c.err = &InvalidError{offset, ch, reason}
+	}
+}
@@ -0,0 +1,45 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposeHangulStrict(t *testing.T) {
+	result, err := ComposeHangulStrict("ㅎㅏ-ㄴㄱㅡ-ㄹ")
+	require.NoError(t, err)
+	assert.Equal(t, "한글", result)
+
+	for word, expected := range map[string]InvalidError{
+		"ㅎㅏ--ㄴ":  {Offset: 7, Char: '-', Reason: "repeated hyphen"},
+		"ㅎㅏ-ㅏ":   {Offset: 6, Char: '-', Reason: "hyphen not followed by a Jaeum"},
+		"ㅎㅏ-":    {Offset: 6, Char: '-', Reason: "hyphen not followed by a Jaeum"},
+		"-ㄴㅏ":    {Offset: 1, Char: 'ㄴ', Reason: "tail without a syllable"},
+		"a-ㄴ":    {Offset: 2, Char: 'ㄴ', Reason: "tail without a syllable"},
+		"ㅎㅏ-ㄴ-ㄴ": {Offset: 11, Char: 'ㄴ', Reason: "tail without a syllable"},
+		"ㄸㅏ-ㄸ":   {Offset: 7, Char: 'ㄸ', Reason: "not a tail"},
+		"ㄳㅏ":     {Offset: 0, Char: 'ㄳ', Reason: "not a lead"},
+	} {
+		result, err := ComposeHangulStrict(word)
+
+		var invalid *InvalidError
+		require.ErrorAs(t, err, &invalid, word)
+		assert.Equal(t, expected, *invalid, word)
+
+		// The result is the same anyway.
+		assert.Equal(t, ComposeHangul(word), result)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate("ㅎㅏ-ㄴㄱㅡ-ㄹ"))
+
+	assert.Equal(t, []Issue{
+		{Offset: 1, Char: 'ㄴ', Desc: "tail without a syllable"},
+		{Offset: 11, Char: '-', Desc: "repeated hyphen"},
+		{Offset: 12, Char: 'ㄸ', Desc: "not a tail"},
+		{Offset: 24, Char: 'ㄱ', Desc: "tail without a syllable"},
+	}, Validate("-ㄴㅎㅏ--ㄸ ㅏ-ㄴ-ㄱ"))
+}
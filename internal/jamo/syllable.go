@@ -0,0 +1,35 @@
+package jamo
+
+// Syllable is a composed Hangul syllable split into Hangul Compatibility
+// Jamo. Tail is 0 if the syllable has no tail.
+type Syllable struct {
+	Lead, Medial, Tail rune
+}
+
+// String composes the syllable.
+func (s Syllable) String() string {
+	return string(join(s.Lead, s.Medial, s.Tail))
+}
+
+// Syllables composes decomposed Jamo phonemes like ComposeHangul and splits
+// each syllable in the result:
+//
+//	jamo.Syllables("ㅎㅏ-ㄴㄱㅡ-ㄹ")
+//	// [{ㅎ ㅏ ㄴ} {ㄱ ㅡ ㄹ}]
+//
+// Composed Hangul syllables are accepted as well. The other letters are
+// skipped.
+func Syllables(word string) []Syllable {
+	var syllables []Syllable
+
+	for _, ch := range ComposeHangul(word) {
+		if !isSyllable(ch) {
+			continue
+		}
+
+		l, m, t := split(ch)
+		syllables = append(syllables, Syllable{l, m, t})
+	}
+
+	return syllables
+}
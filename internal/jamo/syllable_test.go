@@ -0,0 +1,27 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyllables(t *testing.T) {
+	assert.Equal(t, []Syllable{
+		{'ㅎ', 'ㅏ', 'ㄴ'},
+		{'ㄱ', 'ㅡ', 'ㄹ'},
+	}, Syllables("ㅎㅏ-ㄴㄱㅡ-ㄹ"))
+
+	// Composed Hangul and the other letters.
+	assert.Equal(t, []Syllable{
+		{'ㅈ', 'ㅏ', 0},
+		{'ㅁ', 'ㅗ', 0},
+	}, Syllables("자모 123"))
+
+	assert.Nil(t, Syllables("abc"))
+}
+
+func TestSyllableString(t *testing.T) {
+	assert.Equal(t, "값", Syllable{'ㄱ', 'ㅏ', 'ㅄ'}.String())
+	assert.Equal(t, "가", Syllable{'ㄱ', 'ㅏ', 0}.String())
+}
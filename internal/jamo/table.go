@@ -0,0 +1,161 @@
+package jamo
+
+import "unicode/utf8"
+
+// The ranges of the precomposed Hangul syllables and Hangul Compatibility
+// Jamo.
+const (
+	syllableBase = 0xAC00
+	syllableLast = 0xD7A3
+
+	jaeumFirst = 'ㄱ'
+	jaeumLast  = 'ㅎ'
+	moeumFirst = 'ㅏ'
+	moeumLast  = 'ㅣ'
+)
+
+// The bases of the modern conjoining Jamo.
+const (
+	conjoiningLeadBase   = 0x1100
+	conjoiningMedialBase = 0x1161
+	conjoiningTailBase   = 0x11A8
+
+	conjoiningIeung = 0x110B
+)
+
+const (
+	numLeads   = 19
+	numMedials = 21
+	numTails   = 28 // including no tail
+)
+
+// leads and tails are the consonants in the order of the precomposed
+// syllables. The first tail is empty for no tail.
+var (
+	leads = [numLeads]rune{
+		'ㄱ', 'ㄲ', 'ㄴ', 'ㄷ', 'ㄸ', 'ㄹ', 'ㅁ', 'ㅂ', 'ㅃ', 'ㅅ',
+		'ㅆ', 'ㅇ', 'ㅈ', 'ㅉ', 'ㅊ', 'ㅋ', 'ㅌ', 'ㅍ', 'ㅎ',
+	}
+	tails = [numTails]rune{
+		0, 'ㄱ', 'ㄲ', 'ㄳ', 'ㄴ', 'ㄵ', 'ㄶ', 'ㄷ', 'ㄹ', 'ㄺ',
+		'ㄻ', 'ㄼ', 'ㄽ', 'ㄾ', 'ㄿ', 'ㅀ', 'ㅁ', 'ㅂ', 'ㅄ', 'ㅅ',
+		'ㅆ', 'ㅇ', 'ㅈ', 'ㅊ', 'ㅋ', 'ㅌ', 'ㅍ', 'ㅎ',
+	}
+)
+
+// leadIndices and tailIndices are the indices of the consonants in leads and
+// tails by the offsets from jaeumFirst. -1 means that the consonant can't be
+// at the position.
+var leadIndices, tailIndices [jaeumLast - jaeumFirst + 1]int8
+
+func init() {
+	for i := range leadIndices {
+		leadIndices[i] = -1
+		tailIndices[i] = -1
+	}
+	for i, ch := range leads {
+		leadIndices[ch-jaeumFirst] = int8(i)
+	}
+	for i, ch := range tails[1:] {
+		tailIndices[ch-jaeumFirst] = int8(i + 1)
+	}
+}
+
+func isSyllable(ch rune) bool {
+	return syllableBase <= ch && ch <= syllableLast
+}
+
+func isCompatJaeum(ch rune) bool {
+	return jaeumFirst <= ch && ch <= jaeumLast
+}
+
+func isCompatMoeum(ch rune) bool {
+	return moeumFirst <= ch && ch <= moeumLast
+}
+
+// leadIndex finds the index of a lead. It is -1 if ch can't be a lead.
+func leadIndex(ch rune) int {
+	if !isCompatJaeum(ch) {
+		return -1
+	}
+	return int(leadIndices[ch-jaeumFirst])
+}
+
+// medialIndex finds the index of a medial. It is -1 if ch is not a vowel.
+func medialIndex(ch rune) int {
+	if !isCompatMoeum(ch) {
+		return -1
+	}
+	return int(ch - moeumFirst)
+}
+
+// tailIndex finds the index of a tail. It is 0 for no tail and -1 if ch
+// can't be a tail.
+func tailIndex(ch rune) int {
+	if ch == 0 {
+		return 0
+	}
+	if !isCompatJaeum(ch) {
+		return -1
+	}
+	return int(tailIndices[ch-jaeumFirst])
+}
+
+// join composes a syllable from Hangul Compatibility Jamo. It returns
+// utf8.RuneError if the lead or the medial is invalid. An invalid tail is
+// ignored.
+func join(l, m, t rune) rune {
+	li, mi, ti := leadIndex(l), medialIndex(m), tailIndex(t)
+	if li < 0 || mi < 0 {
+		return utf8.RuneError
+	}
+	if ti < 0 {
+		ti = 0
+	}
+	return rune(syllableBase + (li*numMedials+mi)*numTails + ti)
+}
+
+// split decomposes a syllable into Hangul Compatibility Jamo. The tail is 0
+// if the syllable has no tail.
+func split(ch rune) (l, m, t rune) {
+	i := int(ch - syllableBase)
+	return leads[i/(numMedials*numTails)], moeumFirst + rune(i/numTails%numMedials), tails[i%numTails]
+}
+
+// compatJamo converts a modern conjoining Jamo into Hangul Compatibility
+// Jamo. It returns 0 for the other characters.
+func compatJamo(ch rune) rune {
+	switch {
+	case conjoiningLeadBase <= ch && ch < conjoiningLeadBase+numLeads:
+		return leads[ch-conjoiningLeadBase]
+	case conjoiningMedialBase <= ch && ch < conjoiningMedialBase+numMedials:
+		return moeumFirst + ch - conjoiningMedialBase
+	case conjoiningTailBase <= ch && ch < conjoiningTailBase+numTails-1:
+		return tails[ch-conjoiningTailBase+1]
+	}
+	return 0
+}
+
+// leadForm, medialForm and tailForm convert a modern Hangul Compatibility
+// Jamo into the conjoining Jamo at the position. They return 0 if ch can't be
+// at the position.
+func leadForm(ch rune) rune {
+	if i := leadIndex(ch); i >= 0 {
+		return conjoiningLeadBase + rune(i)
+	}
+	return 0
+}
+
+func medialForm(ch rune) rune {
+	if i := medialIndex(ch); i >= 0 {
+		return conjoiningMedialBase + rune(i)
+	}
+	return 0
+}
+
+func tailForm(ch rune) rune {
+	if i := tailIndex(ch); i > 0 {
+		return conjoiningTailBase + rune(i-1)
+	}
+	return 0
+}
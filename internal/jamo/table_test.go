@@ -0,0 +1,32 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinSplit(t *testing.T) {
+	for ch := rune(syllableBase); ch <= syllableLast; ch++ {
+		l, m, tl := split(ch)
+		if !assert.Equal(t, ch, join(l, m, tl)) {
+			return
+		}
+	}
+
+	assert.Equal(t, '값', join('ㄱ', 'ㅏ', 'ㅄ'))
+	assert.Equal(t, '따', join('ㄸ', 'ㅏ', 'ㄸ'))
+	assert.Equal(t, '�', join('ㄳ', 'ㅏ', 0))
+}
+
+func TestCompatJamo(t *testing.T) {
+	for _, ch := range leads {
+		assert.Equal(t, ch, compatJamo(leadForm(ch)))
+	}
+	for ch := rune(moeumFirst); ch <= moeumLast; ch++ {
+		assert.Equal(t, ch, compatJamo(medialForm(ch)))
+	}
+	for _, ch := range tails[1:] {
+		assert.Equal(t, ch, compatJamo(tailForm(ch)))
+	}
+}
@@ -0,0 +1,89 @@
+package jamo
+
+import "fmt"
+
+// ComposeEventKind is the kind of a ComposeEvent.
+type ComposeEventKind int
+
+const (
+	// BufferEvent is reported when a Jamo or a composed Hangul has been
+	// buffered.
+	BufferEvent ComposeEventKind = iota
+
+	// FlushEvent is reported when the buffered Jamo have been written as a
+	// letter.
+	FlushEvent
+
+	// MergeEvent is reported when a Jamo has been merged into the buffered
+	// Jamo, such as a cluster tail.
+	MergeEvent
+)
+
+// ComposeEvent is a step of a Composer reported by WithTrace.
+type ComposeEvent struct {
+	Kind ComposeEventKind
+
+	// Offset is the byte offset of the input character which has caused the
+	// event. It is the length of the input for the final flush.
+	Offset int
+
+	// Char is the buffered or merged character.
+	Char rune
+
+	// Pos is the position of Char in the letter: 0 for a lead, 1 for a
+	// medial and 2 for a tail.
+	Pos int
+
+	// Merged is the Jamo merged from the buffered Jamo and Char.
+	Merged rune
+
+	// Text is the written letter of a flush.
+	Text string
+}
+
+func (e ComposeEvent) String() string {
+	pos := [3]string{"lead", "medial", "tail"}[e.Pos]
+
+	switch e.Kind {
+	case BufferEvent:
+		return fmt.Sprintf("%d: buffer %q as %s", e.Offset, e.Char, pos)
+	case MergeEvent:
+		return fmt.Sprintf("%d: merge %q into %s %q", e.Offset, e.Char, pos, e.Merged)
+	default:
+		return fmt.Sprintf("%d: flush %q", e.Offset, e.Text)
+	}
+}
+
+// WithTrace makes a Composer report each step to fn. It helps to debug why a
+// word has been composed in a way:
+//
+//	jamo.ComposeHangul("ㄹㄹㅏ", jamo.WithTrace(func(e jamo.ComposeEvent) {
+//		fmt.Println(e)
+//	}))
+//	// Output:
+//	// 0: buffer 'ㄹ' as lead
+//	// 3: flush "르"
+//	// 3: buffer 'ㄹ' as lead
+//	// 6: buffer 'ㅏ' as medial
+//	// 9: flush "라"
+func WithTrace(fn func(ComposeEvent)) Option {
+	return func(c *Composer) {
+		c.trace = fn
+	}
+}
+
+// traceBuffer reports that a character has been buffered at the current
+// position.
+func (c *Composer) traceBuffer(ch rune) {
+	if c.trace != nil {
+		c.trace(ComposeEvent{Kind: BufferEvent, Offset: c.cursor, Char: ch, Pos: c.score})
+	}
+}
+
+// traceMerge reports that a character has been merged into the current
+// position.
+func (c *Composer) traceMerge(ch rune) {
+	if c.trace != nil {
+		c.trace(ComposeEvent{Kind: MergeEvent, Offset: c.cursor, Char: ch, Pos: c.score, Merged: c.lmt[c.score]})
+	}
+}
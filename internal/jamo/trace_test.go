@@ -0,0 +1,49 @@
+package jamo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func traceCompose(word string, opts ...Option) []string {
+	var steps []string
+	opts = append(opts, WithTrace(func(e ComposeEvent) {
+		steps = append(steps, e.String())
+	}))
+	ComposeHangul(word, opts...)
+	return steps
+}
+
+func TestTrace(t *testing.T) {
+	assert.Equal(t, []string{
+		`0: buffer 'ㄹ' as lead`,
+		`3: flush "르"`,
+		`3: buffer 'ㄹ' as lead`,
+		`6: buffer 'ㅏ' as medial`,
+		`9: flush "라"`,
+	}, traceCompose("ㄹㄹㅏ"))
+
+	assert.Equal(t, []string{
+		`0: buffer 'ㄱ' as lead`,
+		`3: buffer 'ㅏ' as medial`,
+		`7: buffer 'ㅂ' as tail`,
+		`11: merge 'ㅅ' into tail 'ㅄ'`,
+		`14: flush "값"`,
+	}, traceCompose("ㄱㅏ-ㅂ-ㅅ"))
+
+	assert.Equal(t, []string{
+		`0: buffer '과' as medial`,
+		`3: flush "과"`,
+		`3: buffer 'ㅇ' as lead`,
+		`6: buffer 'ㅣ' as medial`,
+		`9: flush "이"`,
+	}, traceCompose("과ㅇㅣ"))
+
+	assert.Equal(t, []string{
+		`0: buffer 'ㄱ' as lead`,
+		`3: buffer 'ㅗ' as medial`,
+		`6: merge 'ㅏ' into medial 'ㅘ'`,
+		`9: flush "과"`,
+	}, traceCompose("ㄱㅗㅏ", WithCompoundVowels(true)))
+}
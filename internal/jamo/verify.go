@@ -0,0 +1,21 @@
+package jamo
+
+import "fmt"
+
+// Verify checks that a word survives the round trip through Decompose and
+// ComposeHangul with WithEscape. It returns an error with the first differing
+// offset if the composed word is not the word. Any valid UTF-8 word should
+// pass.
+func Verify(word string) error {
+	decomposed := Decompose(word)
+	composed := ComposeHangul(decomposed, WithEscape(true))
+	if composed == word {
+		return nil
+	}
+
+	offset := 0
+	for offset < len(word) && offset < len(composed) && word[offset] == composed[offset] {
+		offset++
+	}
+	return fmt.Errorf("jamo: %q is decomposed into %q but composed into %q at %d", word, decomposed, composed, offset)
+}
@@ -0,0 +1,51 @@
+package jamo
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	for _, word := range []string{"", "한글", "코카-콜라", "ㅋㅋ", `C:\`, "\u1112\u1161", "ﾾￂ"} {
+		assert.NoError(t, Verify(word), word)
+	}
+
+	// Invalid UTF-8 is composed into U+FFFD.
+	assert.Error(t, Verify("한\xff"))
+}
+
+func FuzzVerify(f *testing.F) {
+	for _, word := range []string{"한글라이즈", "값 아", "코카-콜라", "ㄱㅏ-ㄴ", `\-`, "\u1112\u1161\u11ab", "ﾾￂ-ﾤ"} {
+		f.Add(word)
+	}
+
+	f.Fuzz(func(t *testing.T, word string) {
+		if !utf8.ValidString(word) {
+			t.Skip()
+		}
+		if err := Verify(word); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzComposeHangul(f *testing.F) {
+	for _, word := range []string{"ㅎㅏ-ㄴㄱㅡ-ㄹ", "--ㄴ", "-ㄸ", `ㅋ\`, "ㄱㅗㅏ-ㅂ-ㅅ", "ᄒᆞᆫ"} {
+		f.Add(word)
+	}
+
+	f.Fuzz(func(t *testing.T, word string) {
+		composed := ComposeHangul(word, WithCompoundVowels(true))
+		if utf8.ValidString(word) && !utf8.ValidString(composed) {
+			t.Fatalf("%q is composed into invalid UTF-8", word)
+		}
+
+		// The strict mode composes the same result.
+		strict, _ := ComposeHangulStrict(word, WithCompoundVowels(true))
+		if strict != composed {
+			t.Fatalf("%q is composed into %q but %q in the strict mode", word, composed, strict)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package jamo
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// Writer is a streaming ComposeHangul. It composes decomposed Jamo phonemes
+// written to it and writes the composed Hangul syllables to the underlying
+// writer incrementally:
+//
+//	w := jamo.NewWriter(os.Stdout)
+//	io.Copy(w, jamoFile)
+//	w.Close()
+//
+// A letter is written when the next character completes it. So Close must be
+// called to write the final letter.
+type Writer struct {
+	w io.Writer
+	c Composer
+
+	// partial is an incomplete UTF-8 sequence at the end of the last write.
+	partial []byte
+}
+
+// NewWriter creates a Writer which writes to w.
+func NewWriter(w io.Writer, opts ...Option) *Writer {
+	writer := &Writer{w: w}
+	for _, opt := range opts {
+		opt(&writer.c)
+	}
+	return writer
+}
+
+// Write composes p and writes the completed letters to the underlying writer.
+func (w *Writer) Write(p []byte) (int, error) {
+	data := p
+	if len(w.partial) != 0 {
+		data = append(w.partial, p...)
+		w.partial = nil
+	}
+
+	for len(data) != 0 {
+		if !utf8.FullRune(data) {
+			w.partial = append([]byte(nil), data...)
+			break
+		}
+
+		ch, size := utf8.DecodeRune(data)
+		w.c.feed(ch)
+		data = data[size:]
+	}
+
+	if err := w.flush(); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close writes the final letter to the underlying writer. It doesn't close
+// the underlying writer.
+func (w *Writer) Close() error {
+	for len(w.partial) != 0 {
+		ch, size := utf8.DecodeRune(w.partial)
+		w.c.feed(ch)
+		w.partial = w.partial[size:]
+	}
+
+	w.c.close()
+	return w.flush()
+}
+
+// flush writes the composed letters to the underlying writer.
+func (w *Writer) flush() error {
+	if len(w.c.out) == 0 {
+		return nil
+	}
+
+	_, err := w.w.Write(w.c.out)
+	w.c.out = w.c.out[:0]
+	return err
+}
@@ -0,0 +1,42 @@
+package jamo
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	_, err := io.WriteString(w, "ㅎㅏ-ㄴㄱㅡ")
+	require.NoError(t, err)
+
+	// The second letter may take a tail yet.
+	assert.Equal(t, "한", buf.String())
+
+	_, err = io.WriteString(w, "-ㄹ ㄹㅏㅇㅣㅈㅡ")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "한글 라이즈", buf.String())
+}
+
+func TestWriterOneByte(t *testing.T) {
+	word := strings.Repeat("ㅎㅏ-ㄴㄱㅡ-ㄹㄹㅏㅇㅣㅈㅡ, ", 100)
+
+	// Split every multi-byte character.
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	_, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(word)))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, ComposeHangul(word), buf.String())
+}
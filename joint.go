@@ -0,0 +1,97 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// JointPolicy decides how a hyphen or an apostrophe joining the parts of a
+// name, such as "Jean-Pierre" or "O'Brien", is rendered.
+type JointPolicy int
+
+const (
+	// JointsAsIs leaves the joint to the spec.
+	JointsAsIs JointPolicy = iota
+
+	// JointsClosed removes the joint and transcribes the parts as a word:
+	// "D'Angelo" -> "단젤로".
+	JointsClosed
+
+	// JointsSpaced replaces the joint with a space: "Hans-Peter" ->
+	// "한스 페터".
+	JointsSpaced
+
+	// JointsDotted transcribes the parts separately and joins them with
+	// MiddleDot: "Hans-Peter" -> "한스·페터".
+	JointsDotted
+)
+
+// Hyphens chooses a policy for the hyphens between letters. The hyphens are
+// handled before the procedure so that every spec renders them in the same
+// way.
+func Hyphens(policy JointPolicy) Option {
+	return func(o *options) {
+		o.hyphens = policy
+	}
+}
+
+// Apostrophes chooses a policy for the apostrophes between letters. The
+// apostrophes are handled before the procedure so that every spec renders
+// them in the same way.
+func Apostrophes(policy JointPolicy) Option {
+	return func(o *options) {
+		o.apostrophes = policy
+	}
+}
+
+const (
+	hyphens     = "-‐‑"
+	apostrophes = "'’ʼ"
+)
+
+// token is a part of a word followed by a joint in the result.
+type token struct {
+	word  string
+	joint string
+}
+
+// tokenize splits a word at the joints by the policies. The joints which are
+// not between letters are left as they are.
+func (p procedure) tokenize(word string) []token {
+	if p.hyphens == JointsAsIs && p.apostrophes == JointsAsIs {
+		return []token{{word, ""}}
+	}
+
+	var tokens []token
+	var buf strings.Builder
+
+	prev := rune(0)
+	for i, ch := range word {
+		next, _ := utf8.DecodeRuneInString(word[i+utf8.RuneLen(ch):])
+
+		policy := JointsAsIs
+		if unicode.IsLetter(prev) && unicode.IsLetter(next) {
+			switch {
+			case strings.ContainsRune(hyphens, ch):
+				policy = p.hyphens
+			case strings.ContainsRune(apostrophes, ch):
+				policy = p.apostrophes
+			}
+		}
+		prev = ch
+
+		switch policy {
+		case JointsAsIs:
+			buf.WriteRune(ch)
+		case JointsClosed:
+		case JointsSpaced:
+			buf.WriteRune(' ')
+		case JointsDotted:
+			tokens = append(tokens, token{buf.String(), MiddleDot})
+			buf.Reset()
+		}
+	}
+
+	return append(tokens, token{buf.String(), ""})
+}
@@ -0,0 +1,56 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyphens(t *testing.T) {
+	for _, lang := range []string{"deu", "fin"} {
+		h := hangulize.New(loadSpec(lang))
+
+		for policy, expected := range map[hangulize.JointPolicy]string{
+			hangulize.JointsSpaced: " ",
+			hangulize.JointsDotted: "·",
+		} {
+			parts := make([]string, 2)
+			for i, word := range []string{"Hans", "Peter"} {
+				parts[i] = mustHangulizeSpec(t, h.Spec(), word)
+			}
+
+			result, err := h.With(hangulize.Hyphens(policy)).Hangulize("Hans-Peter")
+			require.NoError(t, err)
+			assert.Equal(t, parts[0]+expected+parts[1], result, lang)
+		}
+	}
+
+	h := hangulize.New(loadSpec("deu"), hangulize.Hyphens(hangulize.JointsClosed))
+	result, err := h.Hangulize("Hans-Peter")
+	require.NoError(t, err)
+	assert.NotContains(t, result, "-")
+}
+
+func TestApostrophes(t *testing.T) {
+	h := hangulize.New(loadSpec("ita"))
+
+	for policy, expected := range map[hangulize.JointPolicy]string{
+		hangulize.JointsAsIs:   "단젤로",
+		hangulize.JointsClosed: "단젤로",
+		hangulize.JointsSpaced: "드 안젤로",
+		hangulize.JointsDotted: "드·안젤로",
+	} {
+		result, err := h.With(hangulize.Apostrophes(policy)).Hangulize("D'Angelo")
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, policy)
+	}
+
+	// Not between letters.
+	h = hangulize.New(loadSpec("nld"), hangulize.Apostrophes(hangulize.JointsDotted))
+	assert.Equal(t, "'스헤르토헨보스", mustHangulizeSpec(t, h.Spec(), "'s-Hertogenbosch"))
+	result, err := h.Hangulize("'s-Hertogenbosch")
+	require.NoError(t, err)
+	assert.Equal(t, "'스헤르토헨보스", result)
+}
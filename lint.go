@@ -0,0 +1,84 @@
+package hangulize
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Issue is a suspicious definition in a Spec. It is not an error because the
+// Spec still works, but it is likely a mistake of the spec author.
+type Issue struct {
+	// Section is the HSL section name where the issue has been found, such
+	// as "vars", "rewrite", or "transcribe".
+	Section string
+
+	// Rule is the problematic rule if the section is "rewrite" or
+	// "transcribe".
+	Rule *Rule
+
+	// Var is the problematic var name if the section is "vars".
+	Var string
+
+	Message string
+}
+
+func (i Issue) String() string {
+	switch {
+	case i.Rule != nil:
+		return fmt.Sprintf("%s: %s: %s", i.Section, i.Rule, i.Message)
+	case i.Var != "":
+		return fmt.Sprintf("%s: %#v: %s", i.Section, i.Var, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", i.Section, i.Message)
+}
+
+// Lint finds suspicious definitions in a Spec.
+func Lint(spec *Spec) []Issue {
+	var issues []Issue
+	used := make(map[string]bool)
+
+	lintRules := func(section string, rules []Rule) {
+		for i := range rules {
+			rule := &rules[i]
+
+			fromVars := rule.From.Vars()
+			toVars := rule.To.Vars()
+
+			for _, name := range append(fromVars, toVars...) {
+				used[name] = true
+
+				if _, ok := spec.Vars[name]; !ok {
+					msg := fmt.Sprintf("undefined var <%s>", name)
+					issues = append(issues, Issue{section, rule, "", msg})
+				}
+			}
+
+			// Var-to-var replacement silently fails when the replacement
+			// refers more vars than the pattern.
+			if len(toVars) > len(fromVars) {
+				msg := fmt.Sprintf(
+					"replacement refers %d vars but pattern refers only %d",
+					len(toVars), len(fromVars),
+				)
+				issues = append(issues, Issue{section, rule, "", msg})
+			}
+		}
+	}
+
+	lintRules("rewrite", spec.Rewrite)
+	lintRules("transcribe", spec.Transcribe)
+
+	var unused []string
+	for name := range spec.Vars {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+
+	for _, name := range unused {
+		issues = append(issues, Issue{"vars", nil, name, "unused var"})
+	}
+
+	return issues
+}
@@ -0,0 +1,48 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintBundledSpecs(t *testing.T) {
+	for _, lang := range hangulize.ListLangs() {
+		for _, issue := range hangulize.Lint(loadSpec(lang)) {
+			// Unused vars are harmless.
+			if issue.Var != "" {
+				continue
+			}
+			t.Errorf("%s: %s", lang, issue)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	spec := mustParseSpec(`
+	vars:
+		"abc" = "a", "b", "c"
+		"xyz" = "x", "y", "z"
+		"foo" = "f", "o"
+
+	rewrite:
+		"<abc>"  -> "<xyz>"
+		"<abd>"  -> "d"
+		"c"      -> "<xyz>"
+	`)
+
+	issues := hangulize.Lint(spec)
+	require.Len(t, issues, 3)
+
+	assert.Equal(t, "rewrite", issues[0].Section)
+	assert.Equal(t, 1, issues[0].Rule.ID)
+	assert.Equal(t, "undefined var <abd>", issues[0].Message)
+
+	assert.Equal(t, "rewrite", issues[1].Section)
+	assert.Equal(t, 2, issues[1].Rule.ID)
+
+	assert.Equal(t, "vars", issues[2].Section)
+	assert.Equal(t, "foo", issues[2].Var)
+}
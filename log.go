@@ -0,0 +1,45 @@
+package hangulize
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+// Logger makes a Hangulizer record its events with a slog.Handler:
+//
+//   - WARN: a missing Translit skipped by LenientTranslit
+//   - WARN: an exceeded budget
+//   - INFO: a span which no rule has transcribed
+//
+// Logging is disabled by default.
+func Logger(handler slog.Handler) Option {
+	return func(o *options) {
+		if handler == nil {
+			o.logger = nil
+			return
+		}
+		o.logger = slog.New(handler)
+	}
+}
+
+// pkgLogger is the logger for the package-level events.
+var pkgLogger atomic.Pointer[slog.Logger]
+
+// SetLogger sets a slog.Handler for the package-level events such as spec
+// loads and spec cache hits in LoadSpec. nil disables logging.
+func SetLogger(handler slog.Handler) {
+	if handler == nil {
+		pkgLogger.Store(nil)
+		return
+	}
+	pkgLogger.Store(slog.New(handler))
+}
+
+// logAttrs records an event if the logger is not nil.
+func logAttrs(logger *slog.Logger, level slog.Level, msg string, attrs ...slog.Attr) {
+	if logger == nil {
+		return
+	}
+	logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
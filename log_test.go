@@ -0,0 +1,53 @@
+package hangulize_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+		translit = "stub"
+
+	rewrite:
+		"b" -> "b"
+
+	transcribe:
+		"a" -> "ㅏ"
+	`)
+
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+
+	h := hangulize.New(spec,
+		hangulize.LenientTranslit(true),
+		hangulize.Logger(handler),
+	)
+	_, err := h.Hangulize("ab")
+	assert.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `level=WARN msg="translit missing; skipped" lang=test scheme=stub`)
+	assert.Contains(t, buf.String(), `level=INFO msg=untranscribed lang=test span=b`)
+}
+
+func TestSetLogger(t *testing.T) {
+	var buf bytes.Buffer
+	hangulize.SetLogger(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	defer hangulize.SetLogger(nil)
+
+	hangulize.UnloadSpec("ita")
+	_, err := hangulize.LoadSpec("ita")
+	assert.NoError(t, err)
+	_, err = hangulize.LoadSpec("ita")
+	assert.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `msg="spec loaded" lang=ita`)
+	assert.Contains(t, buf.String(), `msg="spec cache hit" lang=ita`)
+}
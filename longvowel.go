@@ -0,0 +1,233 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hangulize/hangulize/pkg/subword"
+)
+
+// LongVowelPolicy decides how the long vowels in the source are rendered.
+type LongVowelPolicy int
+
+const (
+	// LongVowelsAsIs renders the long vowels as the spec writes.
+	LongVowelsAsIs LongVowelPolicy = iota
+
+	// LongVowelsDoubled repeats the vowel for a long vowel: "Saarinen" ->
+	// "사아리넨", "ラーメン" -> "라아멘".
+	LongVowelsDoubled
+
+	// LongVowelsSingle renders a long vowel as a short one, which is the
+	// loanword orthography: "Boot" -> "보트", "トーキョー" -> "도쿄".
+	LongVowelsSingle
+
+	// LongVowelsMarked renders a long vowel as a short one, but Align marks
+	// the syllables with the long vowels.
+	LongVowelsMarked
+)
+
+// LongVowels chooses a policy for the long vowels. The policy is applied
+// after the "transcribe" step and traced as an "Overlay" step.
+//
+// A long vowel in the source is a repeated vowel letter, such as "aa" in
+// Finnish, or a vowel followed by a length mark, such as "ー" in Japanese
+// Kana or "ː" in IPA.
+//
+// For Japanese, furigana.LongVowels takes precedence if it is not the
+// default. furigana.LongVowelRepeat spells "ー" out as Kana before the spec
+// is applied, so this policy finds no long vowel in the Kana.
+func LongVowels(policy LongVowelPolicy) Option {
+	return func(o *options) {
+		o.longVowels = policy
+	}
+}
+
+// vowelLetters are the vowel letters which are long when repeated.
+const vowelLetters = "aeiouyäöüåæøœáéíóúàèìòùâêîôûëïõаеёиоуыэюяαεηιοωυ"
+
+// findLongVowels finds the spans of the long vowels in a word. A span covers
+// the vowel and the repeated letters or the length marks after it.
+func findLongVowels(word string) []subword.Span {
+	var spans []subword.Span
+
+	extending := false
+	prev, prevStart, prevStop := rune(0), -1, -1
+
+	for i, ch := range word {
+		size := utf8.RuneLen(ch)
+
+		var lengthens bool
+		switch {
+		case prevStop != i:
+		case ch == 'ー':
+			// The prolonged sound mark follows a Kana syllable.
+			lengthens = prev != 0
+		case ch == 'ː':
+			lengthens = isVowelLetter(prev)
+		default:
+			lengthens = ch == prev && isVowelLetter(ch)
+		}
+
+		if lengthens {
+			if extending {
+				spans[len(spans)-1].Stop = i + size
+			} else {
+				spans = append(spans, subword.Span{Start: prevStart, Stop: i + size})
+				extending = true
+			}
+			prevStop = i + size
+			continue
+		}
+
+		extending = false
+		prev, prevStart, prevStop = 0, -1, -1
+		if unicode.IsLetter(ch) {
+			prev, prevStart, prevStop = ch, i, i+size
+		}
+	}
+
+	return spans
+}
+
+func isVowelLetter(ch rune) bool {
+	return ch != 0 && strings.ContainsRune(vowelLetters, unicode.ToLower(ch))
+}
+
+// startsLongVowel reports whether an origin covers the start of a long vowel.
+func startsLongVowel(origin subword.Span, longs []subword.Span) bool {
+	for _, long := range longs {
+		if origin.Start <= long.Start && long.Start < origin.Stop {
+			return true
+		}
+	}
+	return false
+}
+
+// lengthensVowel reports whether an origin is in the repeated letters or the
+// length marks of a long vowel.
+func lengthensVowel(origin subword.Span, longs []subword.Span) bool {
+	if origin.Start == origin.Stop {
+		return false
+	}
+	for _, long := range longs {
+		if long.Start < origin.Start && origin.Stop <= long.Stop {
+			return true
+		}
+	}
+	return false
+}
+
+// plainVowels are the vowels to repeat a vowel with a glide.
+var plainVowels = map[rune]rune{
+	'ㅑ': 'ㅏ', 'ㅘ': 'ㅏ',
+	'ㅕ': 'ㅓ', 'ㅝ': 'ㅓ',
+	'ㅛ': 'ㅗ',
+	'ㅠ': 'ㅜ',
+	'ㅒ': 'ㅐ', 'ㅙ': 'ㅐ',
+	'ㅖ': 'ㅔ', 'ㅞ': 'ㅔ', 'ㅚ': 'ㅔ',
+	'ㅟ': 'ㅣ', 'ㅢ': 'ㅣ',
+}
+
+// applyLongVowels renders the long vowels in the transcribed subwords by the
+// policy. input is the word partitioned into the subwords. The origins of the
+// subwords are required.
+func (p procedure) applyLongVowels(subwords []subword.Subword, input string) []subword.Subword {
+	if p.longVowels == LongVowelsAsIs {
+		return subwords
+	}
+
+	longs := findLongVowels(input)
+	if len(longs) == 0 {
+		return subwords
+	}
+
+	changed := false
+
+	for i, sw := range subwords {
+		if sw.Level != 2 || sw.Origins == nil {
+			continue
+		}
+
+		var chars []rune
+		var origins []subword.Span
+		for j, ch := range sw.Word {
+			chars = append(chars, ch)
+			origins = append(origins, sw.Origins[j])
+		}
+
+		var buf strings.Builder
+		var newOrigins []subword.Span
+
+		write := func(ch rune, origin subword.Span) {
+			buf.WriteRune(ch)
+			newOrigins = append(newOrigins, subword.Fill(string(ch), origin)...)
+		}
+
+		for j := 0; j < len(chars); j++ {
+			ch, origin := chars[j], origins[j]
+
+			if n := repeats(chars, origins, j, longs); n != 0 && p.longVowels != LongVowelsDoubled {
+				j += n - 1
+				changed = true
+				continue
+			}
+
+			write(ch, origin)
+
+			isLastVowel := isMoeum(ch) && (j+1 == len(chars) || !isMoeum(chars[j+1]))
+			if p.longVowels == LongVowelsDoubled && isLastVowel &&
+				startsLongVowel(origin, longs) && repeats(chars, origins, j+1, longs) == 0 {
+				vowel, ok := plainVowels[ch]
+				if !ok {
+					vowel = ch
+				}
+				write('ㅇ', origin)
+				write(vowel, origin)
+				changed = true
+			}
+		}
+
+		subwords[i].Word = buf.String()
+		subwords[i].Origins = newOrigins
+	}
+
+	if changed {
+		var b subword.Builder
+		b.Write(subwords...)
+		p.tracer.Overlay(b.String(), "long vowels")
+	}
+	return subwords
+}
+
+// repeats finds the Jamo at i which repeat the previous vowel for a long
+// vowel, such as "ㅇㅗ" in "ㅂㅗㅇㅗㅌ" or "ㅗ" in "ㅂㅗㅗㅌ". It returns the
+// number of the Jamo.
+func repeats(chars []rune, origins []subword.Span, i int, longs []subword.Span) int {
+	if i == 0 || i >= len(chars) || !isMoeum(chars[i-1]) {
+		return 0
+	}
+
+	n := 0
+	if chars[i] == 'ㅇ' {
+		n++
+	}
+	if i+n >= len(chars) || !isMoeum(chars[i+n]) || !lengthensVowel(origins[i+n], longs) {
+		return 0
+	}
+
+	n++
+	for i+n < len(chars) && isMoeum(chars[i+n]) {
+		n++
+	}
+	return n
+}
+
+// markLong marks the syllables which cover the start of a long vowel.
+func markLong(syllables []Segment, longs []subword.Span) {
+	for i, seg := range syllables {
+		origin := subword.Span{Start: seg.Start, Stop: seg.Stop}
+		syllables[i].Long = startsLongVowel(origin, longs)
+	}
+}
@@ -0,0 +1,86 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/hangulize/hangulize/translit/furigana"
+	"github.com/hangulize/hangulize/translit/romaji"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongVowels(t *testing.T) {
+	words := []struct {
+		lang, word            string
+		asIs, doubled, single string
+	}{
+		{"fin", "Saarinen", "사리넨", "사아리넨", "사리넨"},
+		{"deu", "Boot", "보오트", "보오트", "보트"},
+		{"jpn", "ラーメン", "라멘", "라아멘", "라멘"},
+		{"jpn-ck", "ラーメン", "라아멘", "라아멘", "라멘"},
+		{"jpn-ck", "東京", "토오쿄오", "토오쿄오", "토쿄"},
+		{"ita", "Roma", "로마", "로마", "로마"},
+	}
+
+	for _, w := range words {
+		spec := loadSpec(w.lang)
+
+		for policy, expected := range map[hangulize.LongVowelPolicy]string{
+			hangulize.LongVowelsAsIs:    w.asIs,
+			hangulize.LongVowelsDoubled: w.doubled,
+			hangulize.LongVowelsSingle:  w.single,
+			hangulize.LongVowelsMarked:  w.single,
+		} {
+			h := hangulize.New(spec, hangulize.LongVowels(policy))
+			translit.Install(h)
+
+			result, err := h.Hangulize(w.word)
+			require.NoError(t, err)
+			assert.Equal(t, expected, result, "%s %s %d", w.lang, w.word, policy)
+		}
+	}
+}
+
+func TestLongVowelsFurigana(t *testing.T) {
+	for _, policy := range []hangulize.LongVowelPolicy{
+		hangulize.LongVowelsAsIs,
+		hangulize.LongVowelsDoubled,
+		hangulize.LongVowelsSingle,
+		hangulize.LongVowelsMarked,
+	} {
+		h := hangulize.New(loadSpec("jpn"), hangulize.LongVowels(policy))
+		h.UseTranslit(romaji.T)
+		h.UseTranslit(furigana.New(nil, furigana.LongVowels(furigana.LongVowelRepeat)))
+
+		// The policy of furigana wins.
+		result, err := h.Hangulize("トーキョー")
+		require.NoError(t, err)
+		assert.Equal(t, "도오쿄오", result, "%d", policy)
+	}
+}
+
+func TestLongVowelsTrace(t *testing.T) {
+	h := hangulize.New(loadSpec("deu"), hangulize.LongVowels(hangulize.LongVowelsSingle))
+
+	var traces []hangulize.Trace
+	h.Trace(func(t hangulize.Trace) { traces = append(traces, t) })
+
+	_, err := h.Hangulize("Boot")
+	require.NoError(t, err)
+	assert.Contains(t, traces, hangulize.Trace{Step: "Overlay", Word: "ㅂㅗㅌ", Why: "long vowels"})
+}
+
+func TestLongVowelsMarked(t *testing.T) {
+	h := hangulize.New(loadSpec("fin"), hangulize.LongVowels(hangulize.LongVowelsMarked))
+
+	a, err := hangulize.Align(h, "Saarinen")
+	require.NoError(t, err)
+
+	var long []bool
+	for _, seg := range a.Syllables {
+		long = append(long, seg.Long)
+	}
+	assert.Equal(t, []bool{true, false, false}, long)
+}
@@ -0,0 +1,145 @@
+package hangulize
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hangulize/hangulize/pkg/hsl"
+)
+
+// legacyScripts maps the script names in the legacy specs to the ISO 15924
+// codes.
+var legacyScripts = map[string]string{
+	"roman":    "Latn",
+	"cyrillic": "Cyrl",
+	"georgian": "Geor",
+	"greek":    "Grek",
+	"kana":     "Hrkt",
+}
+
+// legacySections maps the renamed sections to their current names.
+var legacySections = map[string]string{
+	"hangulize": "transcribe",
+}
+
+// MigrateSpec upgrades an HSL source written in the legacy format to the
+// current format:
+//
+//   - A renamed section such as "hangulize" gets its current name such as
+//     "transcribe".
+//   - "code" in the "lang" section, which had ISO 639-1, 2, and 3 codes, is
+//     replaced with "codes" which has only ISO 639-1 and 3 codes.
+//   - A script name such as "roman" is replaced with the ISO 15924 code such
+//     as "Latn".
+//   - "." as a zero-width space in RPatterns of a legacy spec is replaced
+//     with "{}".
+//
+// It returns the migrated source in the canonical layout of FormatSpec and
+// the descriptions of the changes. Comments are preserved. If there's nothing
+// to migrate, the source is returned as is.
+func MigrateSpec(src []byte) ([]byte, []string, error) {
+	if _, err := hsl.Parse(bytes.NewReader(src)); err != nil {
+		return nil, nil, err
+	}
+
+	var f formatter
+	for i, line := range strings.Split(string(src), "\n") {
+		if err := f.feed(line); err != nil {
+			return nil, nil, &hsl.Error{Line: i + 1, Err: err}
+		}
+	}
+
+	var changes []string
+	legacy := false
+
+	names := make(map[string]bool)
+	for _, sec := range f.sections {
+		names[sec.name] = true
+	}
+	for _, sec := range f.sections {
+		name, ok := legacySections[sec.name]
+		if !ok {
+			continue
+		}
+		if names[name] {
+			return nil, nil, fmt.Errorf(
+				"cannot rename section %#v: %#v already exists", sec.name, name,
+			)
+		}
+
+		legacy = true
+		changes = append(changes, fmt.Sprintf("section %#v -> %#v", sec.name, name))
+		sec.name = name
+	}
+
+	for _, sec := range f.sections {
+		if sec.name != "lang" {
+			continue
+		}
+
+		for i := range sec.lines {
+			line := &sec.lines[i]
+
+			switch {
+			case line.key == "code" && line.isPair():
+				legacy = true
+				changes = append(changes, migrateLangCode(line)...)
+
+			case line.key == "script" && len(line.values) == 1:
+				if code, ok := legacyScripts[line.values[0]]; ok {
+					changes = append(changes, fmt.Sprintf(
+						"lang: script %#v -> %#v", line.values[0], code,
+					))
+					line.values[0] = code
+				}
+			}
+		}
+	}
+
+	if legacy {
+		for _, sec := range f.sections {
+			if sec.name != "rewrite" && sec.name != "transcribe" {
+				continue
+			}
+
+			for i := range sec.lines {
+				line := &sec.lines[i]
+
+				for k, val := range line.values {
+					if !strings.Contains(val, ".") {
+						continue
+					}
+					migrated := strings.ReplaceAll(val, ".", "{}")
+					changes = append(changes, fmt.Sprintf(
+						"%s: %#v -> %#v", sec.name, val, migrated,
+					))
+					line.values[k] = migrated
+				}
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		return src, nil, nil
+	}
+
+	var buf bytes.Buffer
+	f.write(&buf, FormatOptions{})
+	return buf.Bytes(), changes, nil
+}
+
+// migrateLangCode renames "code" to "codes" and drops the ISO 639-2 code.
+func migrateLangCode(line *fmtLine) []string {
+	line.key = "codes"
+	changes := []string{`lang: "code" -> "codes"`}
+
+	if len(line.values) == 3 {
+		changes = append(changes, fmt.Sprintf(
+			"lang: ISO 639-2 code %#v dropped", line.values[1],
+		))
+		line.values = []string{line.values[0], line.values[2]}
+	}
+
+	return changes
+}
@@ -0,0 +1,112 @@
+package hangulize_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateSpec(t *testing.T) {
+	src := `
+# legacy spec
+lang:
+    id      = "test"
+    code    = "xx", "xxy", "xxx"
+    script  = "roman"
+
+rewrite:
+    # zero-width space
+    "^glia$" -> "g.lia"
+
+transcribe:
+    "gl" -> "ㄱㄹ"
+    "g"  -> "ㄱ"
+    "l"  -> "ㄹ"
+    "i"  -> "ㅣ"
+    "a"  -> "ㅏ"
+`
+	res, changes, err := hangulize.MigrateSpec([]byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, `# legacy spec
+
+lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+rewrite:
+    # zero-width space
+    "^glia$" -> "g{}lia"
+
+transcribe:
+    "gl" -> "ㄱㄹ"
+    "g"  -> "ㄱ"
+    "l"  -> "ㄹ"
+    "i"  -> "ㅣ"
+    "a"  -> "ㅏ"
+`, string(res))
+
+	assert.Equal(t, []string{
+		`lang: "code" -> "codes"`,
+		`lang: ISO 639-2 code "xxy" dropped`,
+		`lang: script "roman" -> "Latn"`,
+		`rewrite: "g.lia" -> "g{}lia"`,
+	}, changes)
+
+	// "{}" keeps "g" and "l" apart.
+	h := hangulize.New(mustParseSpec(string(res)))
+	word, err := h.Hangulize("glia")
+	require.NoError(t, err)
+	assert.Equal(t, "그리아", word)
+}
+
+func TestMigrateSpecCurrent(t *testing.T) {
+	// The bundled specs are already in the current format.
+	src, err := os.ReadFile("specs/ita.hsl")
+	require.NoError(t, err)
+
+	res, changes, err := hangulize.MigrateSpec(src)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Equal(t, src, res)
+}
+
+func TestMigrateSpecSections(t *testing.T) {
+	src := `lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+hangulize:
+    # the old name of "transcribe"
+    "a" -> "ㅏ"
+    "b" -> "ㅂ"
+`
+	res, changes, err := hangulize.MigrateSpec([]byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, `lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+transcribe:
+    # the old name of "transcribe"
+    "a" -> "ㅏ"
+    "b" -> "ㅂ"
+`, string(res))
+	assert.Equal(t, []string{`section "hangulize" -> "transcribe"`}, changes)
+
+	h := hangulize.New(mustParseSpec(string(res)))
+	word, err := h.Hangulize("ab")
+	require.NoError(t, err)
+	assert.Equal(t, "아브", word)
+
+	// Both of the old and the current sections.
+	_, _, err = hangulize.MigrateSpec([]byte(src + "\ntranscribe:\n    \"c\" -> \"ㅋ\"\n"))
+	assert.Error(t, err)
+}
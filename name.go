@@ -0,0 +1,98 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hangulize/hangulize/pkg/subword"
+)
+
+// MiddleDot joins the components of a foreign personal name in Korean
+// editorial conventions, such as "마르틴·루터".
+const MiddleDot = "·"
+
+// JoinNames makes a Hangulizer regard the input as a foreign personal name.
+// The spaces between the transcribed components of the name are replaced
+// with MiddleDot. Spaces next to untranscribed letters or punctuations are
+// kept. It is applied after the "transcribe" step and traced as an "Overlay"
+// step.
+func JoinNames(enabled bool) Option {
+	return func(o *options) {
+		o.joinNames = enabled
+	}
+}
+
+// joinNameComponents replaces the spaces between the transcribed subwords
+// with MiddleDot. The rules still see the whole name, so the spaces which
+// the rules have removed, such as in "van Rossum" in Dutch, are not joined.
+func (p procedure) joinNameComponents(subwords []subword.Subword) []subword.Subword {
+	if !p.joinNames {
+		return subwords
+	}
+
+	changed := false
+
+	for i, sw := range subwords {
+		if sw.Level == 2 || !hasSpaceOnly(sw.Word) || i == 0 || i+1 == len(subwords) {
+			continue
+		}
+		if !endsWithHangul(subwords[i-1]) || !startsWithHangul(subwords[i+1]) {
+			continue
+		}
+
+		subwords[i].Word = MiddleDot
+		if sw.Origins != nil {
+			origin := sw.Origins[0]
+			for _, o := range sw.Origins[1:] {
+				origin = origin.Union(o)
+			}
+			subwords[i].Origins = subword.Fill(MiddleDot, origin)
+		}
+		changed = true
+	}
+
+	if changed {
+		var b subword.Builder
+		b.Write(subwords...)
+		p.tracer.Overlay(b.String(), "names")
+	}
+	return subwords
+}
+
+// joinNamePieces joins the results of the phrases and the rest of a name.
+// The spaces between them are replaced with MiddleDot if the both sides are
+// Hangul.
+func joinNamePieces(pieces []string) string {
+	var nonEmpty []string
+	for _, piece := range pieces {
+		if piece != "" {
+			nonEmpty = append(nonEmpty, piece)
+		}
+	}
+
+	var buf strings.Builder
+	for i, piece := range nonEmpty {
+		if hasSpaceOnly(piece) && i != 0 && i+1 != len(nonEmpty) {
+			last, _ := utf8.DecodeLastRuneInString(nonEmpty[i-1])
+			first, _ := utf8.DecodeRuneInString(nonEmpty[i+1])
+			if unicode.Is(unicode.Hangul, last) && unicode.Is(unicode.Hangul, first) {
+				piece = MiddleDot
+			}
+		}
+		buf.WriteString(piece)
+	}
+	return buf.String()
+}
+
+// endsWithHangul reports whether a transcribed subword ends with Hangul.
+func endsWithHangul(sw subword.Subword) bool {
+	r, _ := utf8.DecodeLastRuneInString(sw.Word)
+	return sw.Level == 2 && unicode.Is(unicode.Hangul, r)
+}
+
+// startsWithHangul reports whether a transcribed subword starts with Hangul.
+func startsWithHangul(sw subword.Subword) bool {
+	r, _ := utf8.DecodeRuneInString(sw.Word)
+	return sw.Level == 2 && unicode.Is(unicode.Hangul, r)
+}
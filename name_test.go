@@ -0,0 +1,71 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinNames(t *testing.T) {
+	spec, err := hangulize.LoadSpec("deu")
+	require.NoError(t, err)
+	h := hangulize.New(spec, hangulize.JoinNames(true))
+
+	for word, expected := range map[string]string{
+		"Martin Luther":       "마르틴·루터",
+		"Martin  Luther":      "마르틴·루터",
+		"Martin Luther, Bach": "마르틴·루터, 바흐",
+		"Martin":              "마르틴",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+}
+
+func TestJoinNamesAcrossRules(t *testing.T) {
+	// The rules still see the whole name. The spaces which the rules have
+	// removed are not joined.
+	h := hangulize.New(loadSpec("nld"), hangulize.JoinNames(true))
+
+	result, err := h.Hangulize("Guido van Rossum")
+	require.NoError(t, err)
+	assert.Equal(t, "히도·판로쉼", result)
+
+	var traces []hangulize.Trace
+	h.Trace(func(t hangulize.Trace) { traces = append(traces, t) })
+
+	_, err = h.Hangulize("Guido van Rossum")
+	require.NoError(t, err)
+	require.NotEmpty(t, traces)
+	assert.Contains(t, traces, hangulize.Trace{Step: "Overlay", Word: "ㅎㅣㄷㅗ·ㅍㅏ-ㄴㄹㅗㅅㅟ-ㅁ", Why: "names"})
+}
+
+func TestJoinNamesWithPhrases(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id     = "test"
+		codes  = "xx", "xxx"
+		script = "Latn"
+
+	phrases:
+		"New York" = "뉴욕"
+
+	transcribe:
+		"a" -> "ㅏ"
+		"b" -> "ㅂ"
+	`)
+	h := hangulize.New(spec, hangulize.JoinNames(true))
+
+	for word, expected := range map[string]string{
+		"ab New York ab": "아브·뉴욕·아브",
+		"New York":       "뉴욕",
+		" New York, ab ": " 뉴욕, 아브 ",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+}
@@ -0,0 +1,42 @@
+package hangulize
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Option customizes the behavior of a Hangulizer.
+type Option func(*options)
+
+// options is the set of behaviors customized by Options.
+type options struct {
+	lenientTranslit  bool
+	koreanTypography bool
+	joinNames        bool
+
+	syllableSep string
+	groupSep    string
+
+	epentheticVowels map[rune]rune
+	finals           FinalPolicy
+	longVowels       LongVowelPolicy
+
+	hyphens     JointPolicy
+	apostrophes JointPolicy
+
+	// 0 means the default and a negative value means unlimited.
+	stepBudget int
+	timeBudget time.Duration
+
+	logger *slog.Logger
+}
+
+// LenientTranslit makes a Hangulizer skip the Translits which the spec
+// requires but have not been imported, instead of failing with
+// ErrTranslitMissing. The result may be degraded because the word is not
+// transliterated.
+func LenientTranslit(lenient bool) Option {
+	return func(o *options) {
+		o.lenientTranslit = lenient
+	}
+}
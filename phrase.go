@@ -0,0 +1,99 @@
+package hangulize
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// phrase is an entry in the phrase dictionary of a spec.
+type phrase struct {
+	key    string
+	runes  int
+	result string
+}
+
+// newPhrases prepares the phrase dictionary. Longer phrases come first to
+// be matched prior to shorter ones.
+func newPhrases(dict map[string]string) []phrase {
+	phrases := make([]phrase, 0, len(dict))
+	for key, result := range dict {
+		key = norm.NFC.String(key)
+		phrases = append(phrases, phrase{key, utf8.RuneCountInString(key), result})
+	}
+
+	sort.Slice(phrases, func(i, j int) bool {
+		if phrases[i].runes != phrases[j].runes {
+			return phrases[i].runes > phrases[j].runes
+		}
+		return phrases[i].key < phrases[j].key
+	})
+	return phrases
+}
+
+// phraseMatch is a location of a phrase in a word.
+type phraseMatch struct {
+	start  int
+	stop   int
+	result string
+}
+
+// matchPhrases finds the phrases in a word from left to right. A phrase is
+// matched case-insensitively only at word boundaries. The word should be
+// normalized in NFC.
+func matchPhrases(phrases []phrase, word string) []phraseMatch {
+	if len(phrases) == 0 {
+		return nil
+	}
+
+	var matches []phraseMatch
+	prev := rune(-1)
+
+	for i := 0; i < len(word); {
+		if !isWordRune(prev) {
+			if m, ok := matchPhraseAt(phrases, word, i); ok {
+				matches = append(matches, m)
+				prev, _ = utf8.DecodeLastRuneInString(word[:m.stop])
+				i = m.stop
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(word[i:])
+		prev = r
+		i += size
+	}
+
+	return matches
+}
+
+// matchPhraseAt matches the longest phrase at a position of a word.
+func matchPhraseAt(phrases []phrase, word string, start int) (phraseMatch, bool) {
+	for _, ph := range phrases {
+		stop := start
+		for n := 0; n < ph.runes && stop < len(word); n++ {
+			_, size := utf8.DecodeRuneInString(word[stop:])
+			stop += size
+		}
+
+		if !strings.EqualFold(word[start:stop], ph.key) {
+			continue
+		}
+
+		next, _ := utf8.DecodeRuneInString(word[stop:])
+		if stop < len(word) && isWordRune(next) {
+			continue
+		}
+
+		return phraseMatch{start, stop, ph.result}, true
+	}
+	return phraseMatch{}, false
+}
+
+// isWordRune reports whether a rune can be a part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)
+}
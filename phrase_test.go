@@ -0,0 +1,45 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhrases(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id     = "test"
+		codes  = "xx", "xxx"
+		script = "Latn"
+
+	phrases:
+		"New York"  = "뉴욕"
+		"São Paulo" = "상파울루"
+		"New"       = "뉴"
+
+	transcribe:
+		"a" -> "ㅏ"
+		"b" -> "ㅂ"
+	`)
+	h := hangulize.New(spec)
+
+	for word, expected := range map[string]string{
+		"New York":         "뉴욕",
+		"ab new york ab":   "아브 뉴욕 아브",
+		"NEW YORK, ba":     "뉴욕, 바",
+		"New Yorkab":       "뉴 아브",
+		"São Paulo":        "상파울루",
+		"São Paulo":       "상파울루",
+		"banew":            "바",
+		"ab São Paulo, ab": "아브 상파울루, 아브",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+
+	assert.Equal(t, "뉴욕", spec.Phrases["New York"])
+}
@@ -0,0 +1,180 @@
+/*
+Package catalog transcribes the messages in message catalogs of go-i18n,
+gotext and GNU gettext into Hangul.
+
+Proper nouns such as the names of people or places are usually transcribed
+rather than translated. Mark such messages with a tag, "hangulize" by
+default, in the comment for translators:
+
+	// go-i18n
+	{"Vivaldi": {"description": "hangulize", "other": "Vivaldi"}}
+
+	// gotext
+	{"id": "Vivaldi", "message": "Vivaldi", "translatorComment": "hangulize"}
+
+	# GNU gettext
+	#. hangulize
+	msgid "Vivaldi"
+	msgstr ""
+
+Then Transcribe fills the translations of the marked messages:
+
+	spec, _ := hangulize.LoadSpec("ita")
+	n, err := catalog.TranscribeFile(hangulize.New(spec), "ko.po", catalog.Options{})
+*/
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hangulize/hangulize"
+)
+
+// Format is a file format of message catalogs.
+type Format int
+
+const (
+	// GoI18n is the JSON format of github.com/nicksnyder/go-i18n. The
+	// messages in a file for Korean are transcribed in place.
+	GoI18n Format = iota
+
+	// Gotext is the JSON format of golang.org/x/text/cmd/gotext. The
+	// "translation" is filled with the transcribed "message".
+	Gotext
+
+	// PO is the format of GNU gettext. The "msgstr" is filled with the
+	// transcribed "msgid".
+	PO
+)
+
+func (f Format) String() string {
+	switch f {
+	case GoI18n:
+		return "go-i18n"
+	case Gotext:
+		return "gotext"
+	case PO:
+		return "po"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// DefaultTag is the tag which marks the messages to transcribe by default.
+const DefaultTag = "hangulize"
+
+// Options customizes the transcription.
+type Options struct {
+	// Tag marks the messages to transcribe in the comments for translators.
+	// DefaultTag is used if it is empty.
+	Tag string
+
+	// Select chooses the messages to transcribe instead of the Tag.
+	Select func(Message) bool
+}
+
+// Message is a message in a catalog.
+type Message struct {
+	// ID is the identifier of the message.
+	ID string
+
+	// Text is the source text to transcribe.
+	Text string
+
+	// Comment is the comment for translators.
+	Comment string
+}
+
+// selected reports whether a message should be transcribed.
+func (o Options) selected(m Message) bool {
+	if o.Select != nil {
+		return o.Select(m)
+	}
+
+	tag := o.Tag
+	if tag == "" {
+		tag = DefaultTag
+	}
+
+	for _, word := range strings.FieldsFunc(m.Comment, isTagSep) {
+		if strings.TrimPrefix(word, "#") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// isTagSep reports whether a letter separates tags in a comment.
+func isTagSep(ch rune) bool {
+	return strings.ContainsRune(" \t\r\n,;", ch)
+}
+
+// Transcribe reads a catalog from r, transcribes the selected messages and
+// writes the updated catalog to w. It returns the number of the transcribed
+// messages.
+func Transcribe(h hangulize.Hangulizer, r io.Reader, w io.Writer, format Format, opts Options) (int, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		res []byte
+		n   int
+	)
+
+	switch format {
+	case GoI18n:
+		res, n, err = transcribeGoI18n(h, src, opts)
+	case Gotext:
+		res, n, err = transcribeGotext(h, src, opts)
+	case PO:
+		res, n, err = transcribePO(h, src, opts)
+	default:
+		err = fmt.Errorf("unknown catalog format: %s", format)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = w.Write(res)
+	return n, err
+}
+
+// TranscribeFile transcribes the selected messages in a catalog file and
+// writes it back. The format is detected by DetectFormat.
+func TranscribeFile(h hangulize.Hangulizer, name string, opts Options) (int, error) {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	n, err := Transcribe(h, bytes.NewReader(src), &buf, DetectFormat(name, src), opts)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+
+	if n == 0 {
+		return 0, nil
+	}
+	return n, os.WriteFile(name, buf.Bytes(), 0644)
+}
+
+// DetectFormat guesses the format of a catalog by the file name and the
+// content. A JSON file with the "messages" array is for gotext.
+func DetectFormat(name string, src []byte) Format {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".po", ".pot":
+		return PO
+	}
+
+	if isGotext(src) {
+		return Gotext
+	}
+	return GoI18n
+}
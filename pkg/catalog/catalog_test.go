@@ -0,0 +1,160 @@
+package catalog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/catalog"
+)
+
+func newHangulizer(t *testing.T) hangulize.Hangulizer {
+	spec, err := hangulize.LoadSpec("ita")
+	require.NoError(t, err)
+	return hangulize.New(spec)
+}
+
+func transcribe(t *testing.T, src string, format catalog.Format, opts catalog.Options) (string, int) {
+	var buf bytes.Buffer
+	n, err := catalog.Transcribe(newHangulizer(t), strings.NewReader(src), &buf, format, opts)
+	require.NoError(t, err)
+	return buf.String(), n
+}
+
+func TestGoI18n(t *testing.T) {
+	src := `{
+		"Vivaldi": {"description": "hangulize", "other": "Vivaldi"},
+		"Greeting": {"description": "A greeting", "other": "Hello"},
+		"places": {
+			"Roma": {"description": "#hangulize", "other": "Roma"}
+		}
+	}`
+
+	res, n := transcribe(t, src, catalog.GoI18n, catalog.Options{})
+	assert.Equal(t, 2, n)
+
+	var msgs map[string]any
+	require.NoError(t, json.Unmarshal([]byte(res), &msgs))
+
+	assert.Equal(t, "비발디", msgs["Vivaldi"].(map[string]any)["other"])
+	assert.Equal(t, "Hello", msgs["Greeting"].(map[string]any)["other"])
+
+	places := msgs["places"].(map[string]any)
+	assert.Equal(t, "로마", places["Roma"].(map[string]any)["other"])
+}
+
+func TestGoI18nSelect(t *testing.T) {
+	src := `{"Vivaldi": "Vivaldi", "Greeting": "Hello"}`
+
+	opts := catalog.Options{
+		Select: func(m catalog.Message) bool { return m.ID == "Vivaldi" },
+	}
+	res, n := transcribe(t, src, catalog.GoI18n, opts)
+	assert.Equal(t, 1, n)
+	assert.Contains(t, res, `"Vivaldi": "비발디"`)
+	assert.Contains(t, res, `"Greeting": "Hello"`)
+}
+
+func TestGotext(t *testing.T) {
+	src := `{
+		"language": "ko",
+		"messages": [
+			{"id": "Vivaldi", "message": "Vivaldi", "translation": "", "translatorComment": "composer, hangulize"},
+			{"id": "Hello", "message": "Hello", "translation": ""}
+		]
+	}`
+
+	res, n := transcribe(t, src, catalog.Gotext, catalog.Options{})
+	assert.Equal(t, 1, n)
+
+	var file struct {
+		Messages []struct {
+			ID          string `json:"id"`
+			Translation string `json:"translation"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(res), &file))
+
+	assert.Equal(t, "비발디", file.Messages[0].Translation)
+	assert.Equal(t, "", file.Messages[1].Translation)
+}
+
+func TestPO(t *testing.T) {
+	src := `msgid ""
+msgstr ""
+"Language: ko\n"
+
+#. hangulize
+#: main.go:10
+msgid "Vivaldi"
+msgstr ""
+
+#: main.go:20
+msgid "Hello"
+msgstr ""
+
+#, hangulize
+msgid "Roma"
+msgid_plural "Roma"
+msgstr[0] ""
+msgstr[1] ""
+`
+
+	expected := `msgid ""
+msgstr ""
+"Language: ko\n"
+
+#. hangulize
+#: main.go:10
+msgid "Vivaldi"
+msgstr "비발디"
+
+#: main.go:20
+msgid "Hello"
+msgstr ""
+
+#, hangulize
+msgid "Roma"
+msgid_plural "Roma"
+msgstr[0] "로마"
+`
+
+	res, n := transcribe(t, src, catalog.PO, catalog.Options{})
+	assert.Equal(t, 2, n)
+	assert.Equal(t, expected, res)
+}
+
+func TestPOTag(t *testing.T) {
+	src := "# name\nmsgid \"Vivaldi\"\nmsgstr \"\"\n"
+
+	res, n := transcribe(t, src, catalog.PO, catalog.Options{Tag: "name"})
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "# name\nmsgid \"Vivaldi\"\nmsgstr \"비발디\"\n", res)
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, catalog.PO, catalog.DetectFormat("ko.po", nil))
+	assert.Equal(t, catalog.Gotext, catalog.DetectFormat("messages.gotext.json", []byte(`{"messages": []}`)))
+	assert.Equal(t, catalog.GoI18n, catalog.DetectFormat("active.ko.json", []byte(`{"Hello": "Hello"}`)))
+}
+
+func TestTranscribeFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ko.po")
+	src := "#. hangulize\nmsgid \"Vivaldi\"\nmsgstr \"\"\n"
+	require.NoError(t, os.WriteFile(name, []byte(src), 0644))
+
+	n, err := catalog.TranscribeFile(newHangulizer(t), name, catalog.Options{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	res, err := os.ReadFile(name)
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `msgstr "비발디"`)
+}
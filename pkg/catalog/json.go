@@ -0,0 +1,154 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/hangulize/hangulize"
+)
+
+// pluralForms are the keys of the plural forms in a go-i18n message.
+var pluralForms = []string{"zero", "one", "two", "few", "many", "other"}
+
+// isGoI18nMessage reports whether a JSON object is a go-i18n message rather
+// than a group of nested messages.
+func isGoI18nMessage(obj map[string]any) bool {
+	for _, key := range pluralForms {
+		if _, ok := obj[key].(string); ok {
+			return true
+		}
+	}
+	_, ok := obj["description"].(string)
+	return ok
+}
+
+func transcribeGoI18n(h hangulize.Hangulizer, src []byte, opts Options) ([]byte, int, error) {
+	var root map[string]any
+	if err := json.Unmarshal(src, &root); err != nil {
+		return nil, 0, err
+	}
+
+	n := 0
+
+	var walk func(prefix string, obj map[string]any) error
+	walk = func(prefix string, obj map[string]any) error {
+		for key, val := range obj {
+			id := prefix + key
+
+			switch val := val.(type) {
+
+			case string:
+				// A message in the short form: "ID": "Text".
+				if !opts.selected(Message{ID: id, Text: val}) {
+					continue
+				}
+				res, err := h.Hangulize(val)
+				if err != nil {
+					return err
+				}
+				obj[key] = res
+				n++
+
+			case map[string]any:
+				if !isGoI18nMessage(val) {
+					if err := walk(id+".", val); err != nil {
+						return err
+					}
+					continue
+				}
+
+				desc, _ := val["description"].(string)
+				text, _ := val["other"].(string)
+				if !opts.selected(Message{ID: id, Text: text, Comment: desc}) {
+					continue
+				}
+
+				for _, form := range pluralForms {
+					text, ok := val[form].(string)
+					if !ok {
+						continue
+					}
+					res, err := h.Hangulize(text)
+					if err != nil {
+						return err
+					}
+					val[form] = res
+				}
+				n++
+
+			}
+		}
+		return nil
+	}
+
+	if err := walk("", root); err != nil {
+		return nil, 0, err
+	}
+
+	res, err := marshalJSON(root)
+	return res, n, err
+}
+
+// isGotext reports whether a JSON file is a gotext catalog.
+func isGotext(src []byte) bool {
+	var file struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if err := json.Unmarshal(src, &file); err != nil {
+		return false
+	}
+	return file.Messages != nil
+}
+
+func transcribeGotext(h hangulize.Hangulizer, src []byte, opts Options) ([]byte, int, error) {
+	var root map[string]any
+	if err := json.Unmarshal(src, &root); err != nil {
+		return nil, 0, err
+	}
+
+	msgs, _ := root["messages"].([]any)
+	n := 0
+
+	for _, msg := range msgs {
+		msg, ok := msg.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		id, _ := msg["id"].(string)
+		text, _ := msg["message"].(string)
+		comment, _ := msg["translatorComment"].(string)
+		if text == "" {
+			text = id
+		}
+
+		if !opts.selected(Message{ID: id, Text: text, Comment: comment}) {
+			continue
+		}
+
+		res, err := h.Hangulize(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		msg["translation"] = res
+		n++
+	}
+
+	res, err := marshalJSON(root)
+	return res, n, err
+}
+
+// marshalJSON encodes a catalog in the indented JSON without escaping HTML
+// letters such as "<".
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
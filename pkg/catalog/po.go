@@ -0,0 +1,155 @@
+package catalog
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hangulize/hangulize"
+)
+
+// poEntry is an entry in a PO file. The lines are kept as is so that the
+// entries which are not transcribed remain byte by byte.
+type poEntry struct {
+	lines []string
+
+	comments []string
+	msgid    string
+	plural   string
+
+	// msgstr is the index of the first "msgstr" line. It is -1 if the entry
+	// has no "msgstr" such as a block of comments only.
+	msgstr int
+}
+
+// parsePOEntry parses the lines of an entry.
+func parsePOEntry(lines []string) poEntry {
+	e := poEntry{lines: lines, msgstr: -1}
+
+	// The string which the continuation lines are appended to.
+	var cont *string
+
+	for i, line := range lines {
+		line = strings.TrimRight(line, "\r")
+
+		switch {
+
+		case strings.HasPrefix(line, "#."), strings.HasPrefix(line, "#,"):
+			e.comments = append(e.comments, line[2:])
+			cont = nil
+
+		case line == "#", strings.HasPrefix(line, "# "):
+			e.comments = append(e.comments, line[1:])
+			cont = nil
+
+		case strings.HasPrefix(line, "#"):
+			// References, previous strings or obsolete entries.
+			cont = nil
+
+		case strings.HasPrefix(line, "msgid_plural "):
+			e.plural = unquotePO(line[len("msgid_plural "):])
+			cont = &e.plural
+
+		case strings.HasPrefix(line, "msgid "):
+			e.msgid = unquotePO(line[len("msgid "):])
+			cont = &e.msgid
+
+		case strings.HasPrefix(line, "msgstr"):
+			if e.msgstr == -1 {
+				e.msgstr = i
+			}
+			cont = nil
+
+		case strings.HasPrefix(line, `"`):
+			if cont != nil {
+				*cont += unquotePO(line)
+			}
+
+		default:
+			// "msgctxt" or unknown keywords.
+			cont = nil
+
+		}
+	}
+
+	return e
+}
+
+// unquotePO decodes a quoted string in a PO file. A malformed string is
+// decoded as empty.
+func unquotePO(s string) string {
+	s, err := strconv.Unquote(strings.TrimSpace(s))
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+func transcribePO(h hangulize.Hangulizer, src []byte, opts Options) ([]byte, int, error) {
+	var (
+		buf   strings.Builder
+		block []string
+		n     int
+	)
+
+	flush := func() error {
+		if len(block) == 0 {
+			return nil
+		}
+		defer func() { block = nil }()
+
+		e := parsePOEntry(block)
+
+		// The header is the entry with the empty msgid.
+		if e.msgstr == -1 || e.msgid == "" {
+			writeLines(&buf, block)
+			return nil
+		}
+
+		msg := Message{ID: e.msgid, Text: e.msgid, Comment: strings.Join(e.comments, "\n")}
+		if !opts.selected(msg) {
+			writeLines(&buf, block)
+			return nil
+		}
+
+		res, err := h.Hangulize(e.msgid)
+		if err != nil {
+			return err
+		}
+
+		// Replace the "msgstr" lines.
+		writeLines(&buf, block[:e.msgstr])
+
+		if e.plural == "" {
+			buf.WriteString("msgstr " + strconv.Quote(res) + "\n")
+		} else {
+			// Korean has only one plural form.
+			buf.WriteString("msgstr[0] " + strconv.Quote(res) + "\n")
+		}
+
+		n++
+		return nil
+	}
+
+	lines := strings.SplitAfter(string(src), "\n")
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			if err := flush(); err != nil {
+				return nil, 0, err
+			}
+			buf.WriteString(line)
+			continue
+		}
+		block = append(block, line)
+	}
+	if err := flush(); err != nil {
+		return nil, 0, err
+	}
+
+	return []byte(buf.String()), n, nil
+}
+
+func writeLines(buf *strings.Builder, lines []string) {
+	for _, line := range lines {
+		buf.WriteString(line)
+	}
+}
@@ -0,0 +1,346 @@
+/*
+Package corpus hangulizes a plain-text corpus line by line. It is designed for
+the long-running jobs over huge inputs:
+
+	file, _ := os.Open("words.txt")
+	out, _ := os.OpenFile("words.ko.txt", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+
+	progress, err := corpus.Process(file, corpus.Options{
+		Hangulizer: h,
+		Output:     out,
+		StateFile:  "words.state.json",
+		Progress: func(p corpus.Progress) {
+			log.Printf("%d lines, %d errors", p.Lines, p.Errors)
+		},
+	})
+
+The offset of the last line which has been written to the output is saved in
+the state file periodically. When the job is interrupted, Process with the
+same state file skips the lines which have already been processed.
+*/
+package corpus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/stream"
+)
+
+// DefaultCheckpointEvery is the default number of the lines between the
+// checkpoints.
+const DefaultCheckpointEvery = 1000
+
+// Options customizes Process.
+type Options struct {
+	// Hangulizer hangulizes each line. It is required.
+	Hangulizer hangulize.Hangulizer
+
+	// Output is where the results are written line by line. The results are
+	// discarded if it is nil. Open the file in the append mode to resume.
+	Output io.Writer
+
+	// StateFile is the path to the checkpoint. If it exists, Process resumes
+	// from the offset in it. It is removed when Process finishes. Process
+	// doesn't save checkpoints if it is empty.
+	StateFile string
+
+	// CheckpointEvery is the number of the lines between the checkpoints. It
+	// is DefaultCheckpointEvery by default.
+	CheckpointEvery int
+
+	// Progress is called at every checkpoint and at the end.
+	Progress func(Progress)
+
+	// OnError is called when a line fails. If it returns an error, Process
+	// stops with the error. Otherwise, the line is written as is and the
+	// processing continues.
+	OnError func(line int, text string, err error) error
+
+	// Workers is the number of the goroutines which hangulize the lines. The
+	// results are written in the order of the input anyway.
+	Workers int
+
+	// Context stops Process when it is done. Process saves the checkpoint
+	// before it returns the error of the Context.
+	Context context.Context
+}
+
+// Progress is the progress of Process.
+type Progress struct {
+	// Lines is the number of the processed lines including the lines which
+	// had been processed before resuming.
+	Lines int
+
+	// Errors is the number of the failed lines.
+	Errors int
+
+	// Offset is the number of the processed bytes of the input.
+	Offset int64
+
+	// Total is the size of the input. It is 0 if the size is unknown.
+	Total int64
+
+	// Elapsed is the time taken since Process has started. The time before
+	// resuming is not included.
+	Elapsed time.Duration
+}
+
+// State is the checkpoint saved in a state file.
+type State struct {
+	Offset int64 `json:"offset"`
+	Lines  int   `json:"lines"`
+	Errors int   `json:"errors"`
+}
+
+// ReadState reads a state file. It returns the zero State if the file does
+// not exist.
+func ReadState(name string) (State, error) {
+	var s State
+
+	data, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("%s: %w", name, err)
+	}
+	return s, nil
+}
+
+// WriteState writes a state file. The file is replaced atomically so that a
+// crash doesn't leave a broken state.
+func WriteState(name string, s State) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// line is a line in the input with the offset at the end of it.
+type line struct {
+	text string
+	end  int64
+}
+
+// Process hangulizes each line from r and writes the results to the Output.
+// It returns the final progress.
+func Process(r io.Reader, opts Options) (Progress, error) {
+	if opts.Hangulizer == nil {
+		return Progress{}, errors.New("corpus: no Hangulizer")
+	}
+
+	parent := opts.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	checkpointEvery := opts.CheckpointEvery
+	if checkpointEvery <= 0 {
+		checkpointEvery = DefaultCheckpointEvery
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	var state State
+	if opts.StateFile != "" {
+		var err error
+		if state, err = ReadState(opts.StateFile); err != nil {
+			return Progress{}, err
+		}
+	}
+
+	if err := skip(r, state.Offset); err != nil {
+		return Progress{}, err
+	}
+
+	out := bufio.NewWriter(discardIfNil(opts.Output))
+	total := sizeOf(r)
+	start := time.Now()
+
+	progress := func() Progress {
+		return Progress{
+			Lines:   state.Lines,
+			Errors:  state.Errors,
+			Offset:  state.Offset,
+			Total:   total,
+			Elapsed: time.Since(start),
+		}
+	}
+
+	checkpoint := func() error {
+		if err := out.Flush(); err != nil {
+			return err
+		}
+		if opts.StateFile != "" {
+			if err := WriteState(opts.StateFile, state); err != nil {
+				return err
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(progress())
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	// The offsets are queued in the order of the lines. The capacity bounds
+	// the lines in flight.
+	lines := make(chan line, 2*workers+1)
+	words := make(chan string)
+	readErr := make(chan error, 1)
+
+	go func() {
+		readErr <- readLines(ctx, r, state.Offset, lines, words)
+	}()
+
+	results := stream.Pipe(ctx, opts.Hangulizer, words, stream.Options{Workers: workers})
+
+	startLines := state.Lines
+
+	for res := range results {
+		// The results are in the order of the lines. A gap means that the
+		// following results have been dropped by the cancellation, so the
+		// lines after it must not be written.
+		if res.Index != state.Lines-startLines {
+			cancel()
+			break
+		}
+
+		l := <-lines
+		output := res.Output
+
+		if res.Err != nil {
+			if opts.OnError != nil {
+				if err := opts.OnError(state.Lines+1, l.text, res.Err); err != nil {
+					cancel()
+					_ = checkpoint()
+					return progress(), err
+				}
+			}
+			output = l.text
+			state.Errors++
+		}
+
+		if _, err := out.WriteString(output + "\n"); err != nil {
+			return progress(), err
+		}
+
+		state.Lines++
+		state.Offset = l.end
+
+		if state.Lines%checkpointEvery == 0 {
+			if err := checkpoint(); err != nil {
+				return progress(), err
+			}
+		}
+	}
+
+	if err := checkpoint(); err != nil {
+		return progress(), err
+	}
+
+	if err := parent.Err(); err != nil {
+		return progress(), err
+	}
+	if err := <-readErr; err != nil {
+		return progress(), err
+	}
+
+	if opts.StateFile != "" {
+		if err := os.Remove(opts.StateFile); err != nil {
+			return progress(), err
+		}
+	}
+	return progress(), nil
+}
+
+// readLines reads the lines from r and sends them to both of lines and
+// words. offset is the offset of r at the start.
+func readLines(ctx context.Context, r io.Reader, offset int64, lines chan<- line, words chan<- string) error {
+	defer close(words)
+
+	reader := bufio.NewReader(r)
+	for {
+		text, err := reader.ReadString('\n')
+		if text == "" && err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		offset += int64(len(text))
+		text = strings.TrimRight(text, "\r\n")
+
+		select {
+		case lines <- line{text, offset}:
+		case <-ctx.Done():
+			return nil
+		}
+		select {
+		case words <- text:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// skip skips the first n bytes of r. It seeks if r is an io.Seeker.
+func skip(r io.Reader, n int64) error {
+	if n == 0 {
+		return nil
+	}
+
+	if s, ok := r.(io.Seeker); ok {
+		_, err := s.Seek(n, io.SeekStart)
+		return err
+	}
+
+	_, err := io.CopyN(io.Discard, r, n)
+	if err == io.EOF {
+		return fmt.Errorf("corpus: input is shorter than the checkpoint offset %d", n)
+	}
+	return err
+}
+
+// sizeOf finds the size of the input if it is a file.
+func sizeOf(r io.Reader) int64 {
+	f, ok := r.(interface{ Stat() (os.FileInfo, error) })
+	if !ok {
+		return 0
+	}
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return 0
+	}
+	return info.Size()
+}
+
+func discardIfNil(w io.Writer) io.Writer {
+	if w == nil {
+		return io.Discard
+	}
+	return w
+}
@@ -0,0 +1,189 @@
+package corpus_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/corpus"
+)
+
+func newHangulizer(t *testing.T, lang string) hangulize.Hangulizer {
+	spec, err := hangulize.LoadSpec(lang)
+	require.NoError(t, err)
+	return hangulize.New(spec)
+}
+
+func TestProcess(t *testing.T) {
+	var out bytes.Buffer
+	var progresses []corpus.Progress
+
+	p, err := corpus.Process(strings.NewReader("Cappuccino\r\ngelato\nRoma"), corpus.Options{
+		Hangulizer:      newHangulizer(t, "ita"),
+		Output:          &out,
+		CheckpointEvery: 2,
+		Progress:        func(p corpus.Progress) { progresses = append(progresses, p) },
+		Workers:         4,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "카푸치노\n젤라토\n로마\n", out.String())
+	assert.Equal(t, 3, p.Lines)
+	assert.Equal(t, int64(23), p.Offset)
+
+	require.Len(t, progresses, 2)
+	assert.Equal(t, 2, progresses[0].Lines)
+	assert.Equal(t, int64(19), progresses[0].Offset)
+	assert.Equal(t, 3, progresses[1].Lines)
+}
+
+func TestProcessErrors(t *testing.T) {
+	// The Translit for jpn has not been imported.
+	h := newHangulizer(t, "jpn")
+
+	var out bytes.Buffer
+	var failed []int
+
+	p, err := corpus.Process(strings.NewReader("東京\n大阪\n"), corpus.Options{
+		Hangulizer: h,
+		Output:     &out,
+		OnError: func(line int, text string, err error) error {
+			failed = append(failed, line)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	// The failed lines are written as is.
+	assert.Equal(t, "東京\n大阪\n", out.String())
+	assert.Equal(t, 2, p.Errors)
+	assert.Equal(t, []int{1, 2}, failed)
+
+	// OnError stops Process by returning an error.
+	stop := errors.New("stop")
+	_, err = corpus.Process(strings.NewReader("東京\n大阪\n"), corpus.Options{
+		Hangulizer: h,
+		OnError:    func(int, string, error) error { return stop },
+	})
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestProcessResume(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "words.txt")
+	state := filepath.Join(dir, "state.json")
+
+	require.NoError(t, os.WriteFile(input, []byte("Cappuccino\ngelato\nRoma\n"), 0644))
+
+	// The first 11 bytes, "Cappuccino\n", have been processed.
+	require.NoError(t, corpus.WriteState(state, corpus.State{Offset: 11, Lines: 1}))
+
+	file, err := os.Open(input)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var out bytes.Buffer
+	p, err := corpus.Process(file, corpus.Options{
+		Hangulizer: newHangulizer(t, "ita"),
+		Output:     &out,
+		StateFile:  state,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "젤라토\n로마\n", out.String())
+	assert.Equal(t, 3, p.Lines)
+	assert.Equal(t, int64(23), p.Total)
+
+	// The state file is removed at the end.
+	_, err = os.Stat(state)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// failing is a Hangulizer which fails to hangulize "FAIL".
+type failing struct {
+	hangulize.Hangulizer
+}
+
+func (h failing) Hangulize(word string) (string, error) {
+	if word == "FAIL" {
+		return "", errors.New("failed")
+	}
+	return h.Hangulizer.Hangulize(word)
+}
+
+func TestProcessCheckpoint(t *testing.T) {
+	state := filepath.Join(t.TempDir(), "state.json")
+	stop := errors.New("stop")
+
+	// Stop at the 3rd line.
+	_, err := corpus.Process(strings.NewReader("Roma\nRoma\nFAIL\nRoma\n"), corpus.Options{
+		Hangulizer:      failing{newHangulizer(t, "ita")},
+		StateFile:       state,
+		CheckpointEvery: 1,
+		OnError:         func(int, string, error) error { return stop },
+	})
+	assert.ErrorIs(t, err, stop)
+
+	s, err := corpus.ReadState(state)
+	require.NoError(t, err)
+	assert.Equal(t, corpus.State{Offset: 10, Lines: 2}, s)
+}
+
+// cancelling is a Hangulizer which cancels a context at a word.
+type cancelling struct {
+	hangulize.Hangulizer
+	at     string
+	cancel context.CancelFunc
+}
+
+func (h cancelling) Hangulize(word string) (string, error) {
+	if word == h.at {
+		h.cancel()
+	}
+	return h.Hangulizer.Hangulize(word)
+}
+
+func TestProcessCancel(t *testing.T) {
+	h := newHangulizer(t, "ita")
+
+	var input strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&input, "Roma%d\n", i)
+	}
+
+	for n := 0; n < 20; n++ {
+		state := filepath.Join(t.TempDir(), "state.json")
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var out bytes.Buffer
+		_, err := corpus.Process(strings.NewReader(input.String()), corpus.Options{
+			Hangulizer: cancelling{h, "Roma500", cancel},
+			Output:     &out,
+			StateFile:  state,
+			Workers:    8,
+			Context:    ctx,
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		// The output is a prefix of the input and the checkpoint points at
+		// the end of it.
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		for i, line := range lines {
+			require.Equal(t, fmt.Sprintf("로마%d", i), line)
+		}
+
+		s, err := corpus.ReadState(state)
+		require.NoError(t, err)
+		assert.Equal(t, len(lines), s.Lines)
+		assert.Equal(t, int64(strings.Index(input.String(), fmt.Sprintf("Roma%d\n", len(lines)))), s.Offset)
+	}
+}
@@ -0,0 +1,167 @@
+package hre
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"unicode"
+)
+
+// Complexity is a static measure of a Pattern which affects the performance
+// of matching.
+type Complexity struct {
+	// Insts is the number of the instructions in the compiled regexps.
+	Insts int
+
+	// Alternatives is the number of the alternatives in the largest
+	// alternation. Alternatives with a common prefix are counted after they
+	// are factored out.
+	Alternatives int
+
+	// UnboundedPrefix is true if the Pattern starts with an unbounded
+	// repetition, such as "a+" or ".*". Such a Pattern rescans the rest of
+	// the word from every position.
+	UnboundedPrefix bool
+}
+
+// Complexity measures the Pattern statically.
+func (p *Pattern) Complexity() Complexity {
+	var c Complexity
+
+	for i, re := range []*regexp.Regexp{p.re, p.negA, p.negB} {
+		if re == nil {
+			continue
+		}
+
+		tree, err := syntax.Parse(re.String(), syntax.Perl)
+		if err != nil {
+			continue
+		}
+
+		if prog, err := syntax.Compile(tree.Simplify()); err == nil {
+			c.Insts += len(prog.Inst)
+		}
+
+		if n := maxAlternatives(tree); n > c.Alternatives {
+			c.Alternatives = n
+		}
+
+		// Only the positive regexp scans the word.
+		if i == 0 {
+			c.UnboundedPrefix = hasUnboundedPrefix(tree)
+		}
+	}
+
+	return c
+}
+
+// maxAlternatives finds the number of the alternatives in the largest
+// alternation in the regexp.
+func maxAlternatives(re *syntax.Regexp) int {
+	n := 0
+	if re.Op == syntax.OpAlternate {
+		n = len(re.Sub)
+	}
+	for _, sub := range re.Sub {
+		if m := maxAlternatives(sub); m > n {
+			n = m
+		}
+	}
+	return n
+}
+
+// hasUnboundedPrefix reports whether the first letter-consuming element in
+// the regexp is an unbounded repetition. The edges of chunks are skipped
+// although they are expanded to repeated whitespaces.
+func hasUnboundedPrefix(re *syntax.Regexp) bool {
+	switch re.Op {
+
+	case syntax.OpStar, syntax.OpPlus:
+		return !isSpaceClass(re.Sub[0])
+
+	case syntax.OpRepeat:
+		if re.Max == -1 {
+			return !isSpaceClass(re.Sub[0])
+		}
+		return re.Min != 0 && hasUnboundedPrefix(re.Sub[0])
+
+	case syntax.OpCapture:
+		return hasUnboundedPrefix(re.Sub[0])
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if hasUnboundedPrefix(sub) {
+				return true
+			}
+			if !isZeroWidth(sub) && !isEdge(sub) {
+				return false
+			}
+		}
+
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if hasUnboundedPrefix(sub) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isZeroWidth reports whether the regexp never consumes a letter.
+func isZeroWidth(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch,
+		syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return true
+	case syntax.OpCapture:
+		return isZeroWidth(re.Sub[0])
+	}
+	return false
+}
+
+// isEdge reports whether the regexp is an expanded edge of chunks:
+// "(?:^|\s+|{})".
+func isEdge(re *syntax.Regexp) bool {
+	if re.Op == syntax.OpCapture {
+		return isEdge(re.Sub[0])
+	}
+	if re.Op != syntax.OpAlternate {
+		return false
+	}
+	for _, sub := range re.Sub {
+		switch {
+		case isZeroWidth(sub):
+		case sub.Op == syntax.OpPlus && isSpaceClass(sub.Sub[0]):
+		case sub.Op == syntax.OpLiteral && string(sub.Rune) == "{}":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isSpaceClass reports whether the regexp matches only with whitespaces.
+func isSpaceClass(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if !unicode.IsSpace(r) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpCharClass:
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				if !unicode.IsSpace(r) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package hre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplexity(t *testing.T) {
+	c := fixturePattern(`hello`).Complexity()
+	assert.NotZero(t, c.Insts)
+	assert.Equal(t, 0, c.Alternatives)
+	assert.False(t, c.UnboundedPrefix)
+
+	c = fixturePattern(`ab|cd|ef`).Complexity()
+	assert.Equal(t, 3, c.Alternatives)
+
+	assert.True(t, fixturePattern(`a+b`).Complexity().UnboundedPrefix)
+	assert.True(t, fixturePattern(`^.*b`).Complexity().UnboundedPrefix)
+	assert.True(t, fixturePattern(`{~x}<abc>+b`).Complexity().UnboundedPrefix)
+	assert.False(t, fixturePattern(`^ab+$`).Complexity().UnboundedPrefix)
+	assert.False(t, fixturePattern(`b<abc>*`).Complexity().UnboundedPrefix)
+}
@@ -0,0 +1,133 @@
+package hre
+
+import (
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// Examples generates up to n words which the Pattern matches with. Shorter
+// words and earlier alternatives come first. It is useful to demonstrate the
+// behavior of a Pattern without a corpus.
+func (p *Pattern) Examples(n int) []string {
+	re, err := syntax.Parse(p.re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+
+	// Generate more candidates than n because some of them may be rejected
+	// by the negative lookarounds.
+	var examples []string
+	for _, word := range generate(re, n*4) {
+		if word == "" || len(p.Find(word, 1)) == 0 {
+			continue
+		}
+
+		examples = append(examples, word)
+		if len(examples) == n {
+			break
+		}
+	}
+	return examples
+}
+
+// generate enumerates up to n strings which the regexp matches with.
+func generate(re *syntax.Regexp, n int) []string {
+	switch re.Op {
+
+	case syntax.OpLiteral:
+		lit := string(re.Rune)
+		if re.Flags&syntax.FoldCase != 0 {
+			lit = strings.ToLower(lit)
+		}
+		return []string{lit}
+
+	case syntax.OpCharClass:
+		var words []string
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				if len(words) == n {
+					return words
+				}
+				// Skip control characters such as "\x00".
+				if unicode.IsPrint(r) || unicode.IsSpace(r) {
+					words = append(words, string(r))
+				}
+			}
+		}
+		return words
+
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return []string{"a"}
+
+	case syntax.OpCapture, syntax.OpPlus:
+		return generate(re.Sub[0], n)
+
+	case syntax.OpStar, syntax.OpQuest:
+		return union(n, []string{""}, generate(re.Sub[0], n))
+
+	case syntax.OpRepeat:
+		sub := generate(re.Sub[0], n)
+		words := []string{""}
+		for i := 0; i < re.Min; i++ {
+			words = product(n, words, sub)
+		}
+		if re.Min == 0 && re.Max != 0 {
+			words = union(n, words, sub)
+		}
+		return words
+
+	case syntax.OpConcat:
+		words := []string{""}
+		for _, sub := range re.Sub {
+			words = product(n, words, generate(sub, n))
+		}
+		return words
+
+	case syntax.OpAlternate:
+		var words []string
+		for _, sub := range re.Sub {
+			words = union(n, words, generate(sub, n))
+		}
+		return words
+
+	case syntax.OpNoMatch:
+		return nil
+	}
+
+	// Zero-width assertions and empty match.
+	return []string{""}
+}
+
+// product concatenates every pair of the strings, up to n.
+func product(n int, prefixes, suffixes []string) []string {
+	var words []string
+	for _, prefix := range prefixes {
+		for _, suffix := range suffixes {
+			if len(words) == n {
+				return words
+			}
+			words = append(words, prefix+suffix)
+		}
+	}
+	return words
+}
+
+// union merges the strings without duplication, up to n.
+func union(n int, a, b []string) []string {
+	seen := make(map[string]bool, len(a))
+	words := make([]string, 0, len(a)+len(b))
+
+	for _, list := range [][]string{a, b} {
+		for _, word := range list {
+			if len(words) == n {
+				return words
+			}
+			if !seen[word] {
+				seen[word] = true
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
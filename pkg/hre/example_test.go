@@ -0,0 +1,18 @@
+package hre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExamples(t *testing.T) {
+	assert.Equal(t, []string{"hello"}, fixturePattern(`hello`).Examples(3))
+	assert.Equal(t, []string{"ad", "ae", "af"}, fixturePattern(`<abc><def>`).Examples(3))
+	assert.Equal(t, []string{"aa", "ab"}, fixturePattern(`a{@|b}`).Examples(2))
+	assert.Equal(t, []string{"a", "b"}, fixturePattern(`{~x}<abc>`).Examples(2))
+
+	for _, word := range fixturePattern(`^{~x}<abc>+@$`).Examples(5) {
+		assert.NotEmpty(t, fixturePattern(`^{~x}<abc>+@$`).Find(word, 1), word)
+	}
+}
@@ -0,0 +1,300 @@
+package hre
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Explanation describes how a Pattern matches with a word. Matches is empty
+// if the Pattern doesn't match. Then Failure describes the reason.
+type Explanation struct {
+	Pattern *Pattern
+	Word    string
+
+	Matches []Match
+	Failure *Failure
+}
+
+// Match is a location matched by a Pattern.
+type Match struct {
+	Start int
+	Stop  int
+
+	// Vars are the vars captured in the match. A var in a lookaround is not
+	// captured.
+	Vars []VarMatch
+}
+
+// VarMatch is a value of a var taken by a match.
+type VarMatch struct {
+	Name  string
+	Value string
+
+	// Index is the index of Value in the var values.
+	Index int
+
+	Start int
+	Stop  int
+}
+
+// Failure is the position and the reason why a Pattern doesn't match with a
+// word.
+type Failure struct {
+	Pos    int
+	Reason string
+}
+
+// ExplainMatch finds all matches of the Pattern in the word and explains them.
+// If the Pattern doesn't match, it finds the first position of the failure.
+func (p *Pattern) ExplainMatch(word string) *Explanation {
+	e := &Explanation{Pattern: p, Word: word}
+
+	// Remember the first match rejected by a negative lookaround.
+	var rejected *Failure
+	reject := func(start, stop int, ahead bool) {
+		if rejected != nil {
+			return
+		}
+
+		look := "lookbehind"
+		pos := start
+		if ahead {
+			look = "lookahead"
+			pos = stop
+		}
+
+		rejected = &Failure{pos, fmt.Sprintf(
+			"%#v matched but rejected by negative %s",
+			word[start:stop], look,
+		)}
+	}
+
+	names := p.re.SubexpNames()
+
+	for _, m := range p.find(word, -1, reject) {
+		match := Match{Start: m[0], Stop: m[1]}
+
+		// The 1st submatch in m is the 3rd group in the regexp.
+		for i := 1; i*2+1 < len(m); i++ {
+			start, stop := m[i*2], m[i*2+1]
+			if start == -1 {
+				continue
+			}
+
+			varIndex, ok := parseVarGroupName(names[i+2])
+			if !ok || varIndex >= len(p.varNames) {
+				continue
+			}
+
+			val := word[start:stop]
+			match.Vars = append(match.Vars, VarMatch{
+				Name:  p.varNames[varIndex],
+				Value: val,
+				Index: indexOf(val, p.usedVars[varIndex]),
+				Start: start,
+				Stop:  stop,
+			})
+		}
+
+		e.Matches = append(e.Matches, match)
+	}
+
+	if len(e.Matches) != 0 {
+		return e
+	}
+
+	if rejected != nil {
+		e.Failure = rejected
+	} else {
+		e.Failure = p.explainFailure(word)
+	}
+	return e
+}
+
+// parseVarGroupName parses the index of a var from a group name made by
+// varGroupName.
+func parseVarGroupName(name string) (int, bool) {
+	if !strings.HasPrefix(name, "v") {
+		return 0, false
+	}
+	i, err := strconv.Atoi(name[1:])
+	return i, err == nil
+}
+
+func (e *Explanation) String() string {
+	var buf strings.Builder
+
+	if e.Failure != nil {
+		fmt.Fprintf(&buf, "%v doesn't match with %#v at %d: %s",
+			e.Pattern, e.Word, e.Failure.Pos, e.Failure.Reason)
+		return buf.String()
+	}
+
+	fmt.Fprintf(&buf, "%v matches with %#v:", e.Pattern, e.Word)
+	for _, m := range e.Matches {
+		fmt.Fprintf(&buf, "\n  %d-%d %#v", m.Start, m.Stop, e.Word[m.Start:m.Stop])
+		for _, v := range m.Vars {
+			fmt.Fprintf(&buf, " <%s>=%#v", v.Name, v.Value)
+		}
+	}
+	return buf.String()
+}
+
+// -----------------------------------------------------------------------------
+
+// explainFailure runs the positive regexp as an NFA from every position in
+// the word. The attempt which proceeds the longest determines the first
+// failure.
+func (p *Pattern) explainFailure(word string) *Failure {
+	re, err := syntax.Parse(p.re.String(), syntax.Perl)
+	if err != nil {
+		return &Failure{0, err.Error()}
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return &Failure{0, err.Error()}
+	}
+
+	var (
+		furthest = -1
+		longest  = -1
+		waiting  []uint32
+	)
+
+	for start := 0; start <= len(word); {
+		pos := start
+		pcs := nfaClosure(prog, []uint32{uint32(prog.Start)}, word, pos)
+
+		// Proceed while the NFA is alive.
+		for len(pcs) != 0 && pos < len(word) {
+			r, size := utf8.DecodeRuneInString(word[pos:])
+
+			var next []uint32
+			for _, pc := range pcs {
+				inst := &prog.Inst[pc]
+				if inst.Op != syntax.InstMatch && inst.MatchRune(r) {
+					next = append(next, inst.Out)
+				}
+			}
+
+			nextPCs := nfaClosure(prog, next, word, pos+size)
+			if len(nextPCs) == 0 {
+				break
+			}
+			pcs = nextPCs
+			pos += size
+		}
+
+		if len(pcs) != 0 && pos-start > longest {
+			longest = pos - start
+			furthest = pos
+			waiting = pcs
+		}
+
+		if start == len(word) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(word[start:])
+		start += size
+	}
+
+	if furthest == -1 {
+		return &Failure{0, "never matches"}
+	}
+
+	var expected []string
+	seen := make(map[string]bool)
+	for _, pc := range waiting {
+		desc := describeInst(&prog.Inst[pc])
+		if desc != "" && !seen[desc] {
+			seen[desc] = true
+			expected = append(expected, desc)
+		}
+	}
+
+	var got string
+	if furthest == len(word) {
+		got = "unexpected end of word"
+	} else {
+		r, _ := utf8.DecodeRuneInString(word[furthest:])
+		got = fmt.Sprintf("unexpected %s", strconv.QuoteRune(r))
+	}
+
+	if len(expected) == 0 {
+		return &Failure{furthest, got}
+	}
+	reason := fmt.Sprintf("%s, expected %s", got, strings.Join(expected, " or "))
+	return &Failure{furthest, reason}
+}
+
+// nfaClosure follows the empty transitions from the instructions at the
+// position. It returns the instructions which consume a rune or match.
+func nfaClosure(prog *syntax.Prog, pcs []uint32, word string, pos int) []uint32 {
+	before, after := rune(-1), rune(-1)
+	if pos > 0 {
+		before, _ = utf8.DecodeLastRuneInString(word[:pos])
+	}
+	if pos < len(word) {
+		after, _ = utf8.DecodeRuneInString(word[pos:])
+	}
+	empty := syntax.EmptyOpContext(before, after)
+
+	var closure []uint32
+	visited := make(map[uint32]bool)
+
+	var visit func(pc uint32)
+	visit = func(pc uint32) {
+		if visited[pc] {
+			return
+		}
+		visited[pc] = true
+
+		inst := &prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			visit(inst.Out)
+			visit(inst.Arg)
+		case syntax.InstCapture, syntax.InstNop:
+			visit(inst.Out)
+		case syntax.InstEmptyWidth:
+			if syntax.EmptyOp(inst.Arg)&^empty == 0 {
+				visit(inst.Out)
+			}
+		case syntax.InstFail:
+		default:
+			closure = append(closure, pc)
+		}
+	}
+
+	for _, pc := range pcs {
+		visit(pc)
+	}
+	return closure
+}
+
+// describeInst describes the runes which an instruction consumes.
+func describeInst(inst *syntax.Inst) string {
+	switch inst.Op {
+	case syntax.InstRune1:
+		return strconv.QuoteRune(inst.Rune[0])
+	case syntax.InstRune:
+		var ranges []string
+		for i := 0; i+1 < len(inst.Rune); i += 2 {
+			lo, hi := inst.Rune[i], inst.Rune[i+1]
+			if lo == hi {
+				ranges = append(ranges, strconv.QuoteRune(lo))
+			} else {
+				ranges = append(ranges, fmt.Sprintf(
+					"%s-%s", strconv.QuoteRune(lo), strconv.QuoteRune(hi),
+				))
+			}
+		}
+		return strings.Join(ranges, " or ")
+	case syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+		return "any letter"
+	}
+	return ""
+}
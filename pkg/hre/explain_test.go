@@ -0,0 +1,49 @@
+package hre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainMatch(t *testing.T) {
+	p := fixturePattern(`{<abc>}@<def>`)
+
+	e := p.ExplainMatch("xbef_ce")
+	require.Nil(t, e.Failure)
+	require.Len(t, e.Matches, 1)
+
+	m := e.Matches[0]
+	assert.Equal(t, 2, m.Start)
+	assert.Equal(t, 4, m.Stop)
+	assert.Equal(t, []VarMatch{
+		{"vowels", "e", 1, 2, 3},
+		{"def", "f", 2, 3, 4},
+	}, m.Vars)
+
+	assert.Equal(t, "{<abc>}@<def> matches with \"xbef_ce\":\n  2-4 \"ef\" <vowels>=\"e\" <def>=\"f\"", e.String())
+}
+
+func TestExplainMatchFailure(t *testing.T) {
+	var e *Explanation
+
+	e = fixturePattern(`hello`).ExplainMatch("help")
+	require.NotNil(t, e.Failure)
+	assert.Equal(t, 3, e.Failure.Pos)
+	assert.Equal(t, `unexpected 'p', expected 'l'`, e.Failure.Reason)
+
+	e = fixturePattern(`ab<abc>`).ExplainMatch("xab")
+	require.NotNil(t, e.Failure)
+	assert.Equal(t, 3, e.Failure.Pos)
+	assert.Equal(t, `unexpected end of word, expected 'a'-'c'`, e.Failure.Reason)
+
+	e = fixturePattern(`a$`).ExplainMatch("ab")
+	require.NotNil(t, e.Failure)
+	assert.Equal(t, 1, e.Failure.Pos)
+
+	e = fixturePattern(`a{~b}`).ExplainMatch("ab")
+	require.NotNil(t, e.Failure)
+	assert.Equal(t, 1, e.Failure.Pos)
+	assert.Equal(t, `"a" matched but rejected by negative lookahead`, e.Failure.Reason)
+}
@@ -49,6 +49,9 @@ type Pattern struct {
 
 	// References to expanded vars.
 	usedVars [][]string
+
+	// Names of the expanded vars.
+	varNames []string
 }
 
 func (p *Pattern) String() string {
@@ -73,6 +76,7 @@ func NewPattern(
 
 	reExpr = expandMacros(reExpr, macros)
 
+	varNames := varNamesIn(reExpr)
 	reExpr, usedVars := expandVars(reExpr, vars)
 
 	reExpr, negAExpr, negBExpr, negAWidth, negBWidth, err :=
@@ -116,7 +120,12 @@ func NewPattern(
 		}
 	}
 
-	p := &Pattern{expr, re, negA, negB, negAWidth, negBWidth, letters, usedVars}
+	p := &Pattern{
+		expr,
+		re, negA, negB,
+		negAWidth, negBWidth,
+		letters, usedVars, varNames,
+	}
 	return p, nil
 }
 
@@ -135,6 +144,12 @@ func (p *Pattern) Letters() []rune {
 	return letters
 }
 
+// Vars returns the names of the vars referenced in the expression after
+// macros are expanded. A name appears as many times as it is referenced.
+func (p *Pattern) Vars() []string {
+	return append([]string(nil), p.varNames...)
+}
+
 // Explain shows the HRE expression with
 // the underlying standard regexp patterns.
 func (p *Pattern) Explain() string {
@@ -176,6 +191,12 @@ func pickStartStop(m []int) (int, int) {
 // Find searches up to n matches in the word. If n is -1, it will search all
 // matches. The result is an array of submatch locations.
 func (p *Pattern) Find(word string, n int) [][]int {
+	return p.find(word, n, nil)
+}
+
+// find implements Find. If reject is not nil, it will be called with the
+// start and stop of a match which is rejected by a negative lookaround.
+func (p *Pattern) find(word string, n int, reject func(start, stop int, ahead bool)) [][]int {
 	var matches [][]int
 
 	offset := 0
@@ -231,6 +252,9 @@ func (p *Pattern) Find(word string, n int) [][]int {
 			}
 
 			if p.negA.MatchString(substr(word, negAStart, negAStop)) {
+				if reject != nil {
+					reject(start, stop, true)
+				}
 				continue
 			}
 		}
@@ -247,6 +271,9 @@ func (p *Pattern) Find(word string, n int) [][]int {
 			}
 
 			if p.negB.MatchString(substr(word, negBStart, negBStop)) {
+				if reject != nil {
+					reject(start, stop, false)
+				}
 				continue
 			}
 		}
@@ -376,6 +376,14 @@ func TestReplaceWithVars(t *testing.T) {
 	assert.Equal(t, "xysolutely", p.Replace("absolutely", rp, -1))
 }
 
+func TestVarNames(t *testing.T) {
+	p := fixturePattern("{<abc>}@<def>@")
+	assert.Equal(t, []string{"abc", "vowels", "def", "vowels"}, p.Vars())
+
+	rp := NewRPattern("<def>x<abc>", nil, nil)
+	assert.Equal(t, []string{"def", "abc"}, rp.Vars())
+}
+
 func TestShiftedSubmatchIndex(t *testing.T) {
 	p, _ := NewPattern("-|'", nil, nil)
 	assert.Equal(t, [][]int{{0, 1}}, p.Find("-", -1))
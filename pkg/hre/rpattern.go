@@ -133,6 +133,19 @@ func (rp *RPattern) Interpolate(
 	return buf.String(), nil
 }
 
+// Vars returns the names of the vars referenced in the expression after
+// macros are expanded. A name appears as many times as it is referenced.
+func (rp *RPattern) Vars() []string {
+	var names []string
+	for _, part := range rp.parts {
+		if part.tok == toVar {
+			name, _ := getVar(part.lit, nil)
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // Letters returns the set of natural letters used in the expression in
 // ascending order.
 func (rp *RPattern) Letters() []rune {
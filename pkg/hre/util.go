@@ -2,7 +2,6 @@ package hre
 
 import (
 	"regexp"
-	"strings"
 )
 
 // indexOf finds the index of the given value in a string array. It returns -1
@@ -43,9 +42,12 @@ func captured(s string, m []int, n int) string {
 	return substr(s, m[i], m[i+1])
 }
 
+// reCapture matches with the group starters "(", "(?:", and "(?P<...>".
+var reCapture = regexp.MustCompile(`\((?:\?:|\?P<\w+>)?`)
+
 // noCapture removes capturing groups in a regexp string.
 func noCapture(expr string) string {
-	return strings.Replace(expr, "(", "(?:", -1)
+	return reCapture.ReplaceAllString(expr, "(?:")
 }
 
 // -----------------------------------------------------------------------------
@@ -2,6 +2,7 @@ package hre
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -12,6 +13,8 @@ import (
 var reVar = re(`<(.+?)>`)
 
 // expandVars replaces <var> to corresponding content Regexp such as (a|b|c).
+// The group is named by the order of the var, such as (?P<v0>a|b|c), to find
+// which var has been captured by the group.
 func expandVars(expr string, vars map[string][]string) (string, [][]string) {
 	var usedVars [][]string
 
@@ -19,20 +22,35 @@ func expandVars(expr string, vars map[string][]string) (string, [][]string) {
 		// Retrieve variable name and values.
 		_, vals := getVar(varExpr, vars)
 
+		groupName := varGroupName(len(usedVars))
 		usedVars = append(usedVars, vals)
 
-		// Build as Regexp like /(a|b|c)/.
+		// Build as Regexp like /(?P<v0>a|b|c)/.
 		escapedVals := make([]string, len(vals))
 		for i, val := range vals {
 			escapedVals[i] = regexp.QuoteMeta(val)
 		}
 
-		return `(` + strings.Join(escapedVals, `|`) + `)`
+		return `(?P<` + groupName + `>` + strings.Join(escapedVals, `|`) + `)`
 	})
 
 	return expr, usedVars
 }
 
+// varGroupName returns the name of the capturing group for the i-th var.
+func varGroupName(i int) string {
+	return "v" + strconv.Itoa(i)
+}
+
+// varNamesIn collects the names of vars in an expression in their order.
+func varNamesIn(expr string) []string {
+	var names []string
+	for _, m := range reVar.FindAllStringSubmatch(expr, -1) {
+		names = append(names, m[1])
+	}
+	return names
+}
+
 // getVar parses a var expression, which looks like "<var>",
 // and returns the var name and values.
 func getVar(expr string, vars map[string][]string) (string, []string) {
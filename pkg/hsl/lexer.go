@@ -154,6 +154,10 @@ func (l *lexer) scanQuotedString() (token, string) {
 	for {
 		ch := l.read()
 
+		if ch == eof {
+			return Illegal, `"` + buf.String()
+		}
+
 		if ch == '"' {
 			if escaped {
 				escaped = false
@@ -183,7 +187,7 @@ func (l *lexer) scanArrow() (token, string) {
 	second := l.read()
 
 	if first != '-' || second != '>' {
-		panic("not ->")
+		return Illegal, string([]rune{first, second})
 	}
 
 	return Arrow, "->"
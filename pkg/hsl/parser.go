@@ -44,7 +44,8 @@ func (p *parser) parse() (HSL, error) {
 
 		// The common behavior for useless tokens.
 		if tok == Illegal {
-			return nil, fmt.Errorf("parse: %w: %s", errIllegalToken, lit)
+			err := fmt.Errorf("parse: %w: %s", errIllegalToken, lit)
+			return nil, &Error{line, err}
 		} else if tok == EOF {
 			break
 		} else if tok == Comment {
@@ -66,7 +67,8 @@ func (p *parser) parse() (HSL, error) {
 
 		if tok == Equal || tok == Arrow {
 			if sectionName == "" {
-				return nil, errors.New("pair found not in section")
+				err := errors.New("pair found not in section")
+				return nil, &Error{line, err}
 			}
 
 			values, err := p.parseValues()
@@ -100,7 +102,7 @@ func (p *parser) parse() (HSL, error) {
 			}
 
 			if err := section.addPair(lastString, values, line); err != nil {
-				panic(fmt.Errorf("failed to add pair: %w", err))
+				return nil, &Error{line, err}
 			}
 
 			continue
@@ -114,11 +116,12 @@ func (p *parser) parseValues() ([]string, error) {
 	values := make([]string, 0)
 
 	for {
-		tok, lit, _ := p.scan()
+		tok, lit, line := p.scan()
 
 		// The common behavior for useless tokens.
 		if tok == Illegal {
-			return nil, fmt.Errorf("parse values: %w: %s", errIllegalToken, lit)
+			err := fmt.Errorf("parse values: %w: %s", errIllegalToken, lit)
+			return nil, &Error{line, err}
 		} else if tok == EOF {
 			break
 		} else if tok == Comment {
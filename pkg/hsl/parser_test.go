@@ -66,3 +66,52 @@ func TestLine(t *testing.T) {
 	assert.Equal(t, 4, hsl["bar"].(*ListSection).Line())
 	assert.Equal(t, 6, hsl["bar"].Pairs()[0].Line())
 }
+
+func TestParseErrorLine(t *testing.T) {
+	p := _newParser(`
+	foo:
+		hello = "world"
+		bye ! "world"
+	`)
+
+	_, err := p.parse()
+
+	var parseErr *Error
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 3, parseErr.Line)
+	assert.ErrorIs(t, err, errIllegalToken)
+}
+
+func TestParseUnterminatedString(t *testing.T) {
+	p := _newParser(`
+	foo:
+		"hello" -> "world
+	`)
+
+	_, err := p.parse()
+	assert.ErrorIs(t, err, errIllegalToken)
+}
+
+func TestParseBrokenArrow(t *testing.T) {
+	p := _newParser(`
+	foo:
+		"hello" -- "world"
+	`)
+
+	_, err := p.parse()
+	assert.ErrorIs(t, err, errIllegalToken)
+}
+
+func TestParseDuplicatedKey(t *testing.T) {
+	p := _newParser(`
+	foo:
+		hello = "world"
+		hello = "again"
+	`)
+
+	_, err := p.parse()
+
+	var parseErr *Error
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 3, parseErr.Line)
+}
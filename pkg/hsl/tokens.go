@@ -1,9 +1,27 @@
 package hsl
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var errIllegalToken = errors.New("illegal token")
 
+// Error is a parsing error with the line number where it occurred.
+type Error struct {
+	Line int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 // token represents a meaningful string in HSL format.
 type token int
 
@@ -0,0 +1,204 @@
+/*
+Package stream hangulizes the words flowing through channels concurrently. It
+is designed to be embedded in the consumers of message queues such as Kafka
+or NATS:
+
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for msg := range consumer.Messages() {
+			in <- msg.Word
+		}
+	}()
+
+	for res := range stream.Pipe(ctx, h, in, stream.Options{}) {
+		if res.Err != nil {
+			log.Println(res.Index, res.Err)
+			continue
+		}
+		producer.Send(res.Output)
+	}
+
+The number of the words in flight is bounded. When the receiver is slower
+than the sender, Pipe stops reading the input so that the backpressure is
+propagated to the upstream.
+*/
+package stream
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/hangulize/hangulize"
+)
+
+// Result is the result of a word in the input.
+type Result struct {
+	// Index is the position of the word in the input, starting at 0.
+	Index int
+
+	Word   string
+	Output string
+
+	// Err is the error of the word. The following words are still processed.
+	Err error
+}
+
+// Options customizes a Pipe.
+type Options struct {
+	// Workers is the number of the goroutines which hangulize the words. It
+	// is runtime.GOMAXPROCS(0) by default.
+	Workers int
+
+	// Buffer is the number of the results which can be held until the
+	// receiver takes them. It is the same as Workers by default. In the
+	// ordered mode, the results waiting for a slow preceding word are also
+	// held in the buffer.
+	Buffer int
+
+	// Unordered emits the results as soon as they are ready instead of in
+	// the order of the input. It keeps the throughput when the words take
+	// different time to hangulize.
+	Unordered bool
+}
+
+// job is a word to hangulize with its position.
+type job struct {
+	index int
+	word  string
+}
+
+// Pipe hangulizes the words from in by h and sends the results to the
+// returned channel. The channel is closed after in is closed and all the
+// results are sent, or ctx is done. In the ordered mode, the results sent
+// before ctx is done are always the leading words of the input without a gap.
+//
+// The Hangulizer is shared by the workers. It should not be modified while
+// Pipe is running.
+func Pipe(ctx context.Context, h hangulize.Hangulizer, in <-chan string, opts Options) <-chan Result {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = workers
+	}
+
+	// A slot is taken before a word is read and is given back after the
+	// result is emitted. So the words in flight are never more than slots.
+	slots := make(chan struct{}, workers+buffer)
+
+	jobs := make(chan job)
+	results := make(chan Result, workers)
+	out := make(chan Result, buffer)
+
+	// Dispatcher
+	go func() {
+		defer close(jobs)
+
+		for i := 0; ; i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			var (
+				word string
+				ok   bool
+			)
+			select {
+			case word, ok = <-in:
+			case <-ctx.Done():
+			}
+			if !ok {
+				return
+			}
+
+			jobs <- job{i, word}
+		}
+	}()
+
+	// Workers
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for j := range jobs {
+				output, err := h.Hangulize(j.word)
+				results <- Result{j.index, j.word, output, err}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Collector
+	go func() {
+		defer close(out)
+
+		// emit reports whether the result has been sent. It fails after ctx
+		// is done.
+		emit := func(res Result) bool {
+			defer func() { <-slots }()
+
+			if ctx.Err() != nil {
+				return false
+			}
+			select {
+			case out <- res:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if opts.Unordered {
+			for res := range results {
+				emit(res)
+			}
+			return
+		}
+
+		// In the ordered mode, the results are emitted without a gap. Once a
+		// result is dropped by the cancellation, the following results are
+		// dropped too.
+		next := 0
+		pending := make(map[int]Result)
+		stopped := false
+
+		for res := range results {
+			if stopped {
+				<-slots
+				continue
+			}
+			pending[res.Index] = res
+
+			for {
+				res, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				if !emit(res) {
+					stopped = true
+					for range pending {
+						<-slots
+					}
+					break
+				}
+				next++
+			}
+		}
+	}()
+
+	return out
+}
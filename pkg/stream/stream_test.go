@@ -0,0 +1,164 @@
+package stream_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/pkg/stream"
+)
+
+func newHangulizer(t *testing.T, lang string) hangulize.Hangulizer {
+	spec, err := hangulize.LoadSpec(lang)
+	require.NoError(t, err)
+	return hangulize.New(spec)
+}
+
+// feed sends the words to a channel and closes it.
+func feed(words ...string) <-chan string {
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, word := range words {
+			in <- word
+		}
+	}()
+	return in
+}
+
+func TestPipeOrdered(t *testing.T) {
+	h := newHangulizer(t, "ita")
+
+	words := make([]string, 0, 300)
+	for i := 0; i < 100; i++ {
+		words = append(words, "Cappuccino", "gelato", "Roma")
+	}
+
+	opts := stream.Options{Workers: 4, Buffer: 2}
+
+	i := 0
+	for res := range stream.Pipe(context.Background(), h, feed(words...), opts) {
+		require.Equal(t, i, res.Index)
+		assert.Equal(t, words[i], res.Word)
+		assert.NoError(t, res.Err)
+		i++
+	}
+	assert.Equal(t, len(words), i)
+}
+
+func TestPipeUnordered(t *testing.T) {
+	h := newHangulizer(t, "ita")
+
+	words := make([]string, 100)
+	for i := range words {
+		words[i] = fmt.Sprintf("Roma%d", i)
+	}
+
+	opts := stream.Options{Workers: 4, Unordered: true}
+
+	var indices []int
+	for res := range stream.Pipe(context.Background(), h, feed(words...), opts) {
+		assert.Equal(t, fmt.Sprintf("로마%d", res.Index), res.Output)
+		indices = append(indices, res.Index)
+	}
+
+	sort.Ints(indices)
+	for i, index := range indices {
+		assert.Equal(t, i, index)
+	}
+	assert.Len(t, indices, len(words))
+}
+
+func TestPipeErrors(t *testing.T) {
+	// The Translit for jpn has not been imported.
+	h := newHangulizer(t, "jpn")
+
+	var errs []error
+	for res := range stream.Pipe(context.Background(), h, feed("東京", "大阪"), stream.Options{}) {
+		errs = append(errs, res.Err)
+	}
+
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], hangulize.ErrTranslitMissing)
+	assert.ErrorIs(t, errs[1], hangulize.ErrTranslitMissing)
+}
+
+func TestPipeCancel(t *testing.T) {
+	h := newHangulizer(t, "ita")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// An endless input.
+	in := make(chan string)
+	go func() {
+		for {
+			select {
+			case in <- "Roma":
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := stream.Pipe(ctx, h, in, stream.Options{Workers: 2})
+
+	for i := 0; i < 10; i++ {
+		<-out
+	}
+	cancel()
+
+	// The output is closed after the cancellation.
+	for range out {
+	}
+}
+
+// cancelling is a Hangulizer which cancels a context at a word.
+type cancelling struct {
+	hangulize.Hangulizer
+	at     string
+	cancel context.CancelFunc
+}
+
+func (h cancelling) Hangulize(word string) (string, error) {
+	if word == h.at {
+		h.cancel()
+	}
+	return h.Hangulizer.Hangulize(word)
+}
+
+func TestPipeCancelOrdered(t *testing.T) {
+	h := newHangulizer(t, "ita")
+
+	words := make([]string, 1000)
+	for i := range words {
+		words[i] = fmt.Sprintf("Roma%d", i)
+	}
+
+	for n := 0; n < 20; n++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan string)
+		go func() {
+			defer close(in)
+			for _, word := range words {
+				select {
+				case in <- word:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		// No result is skipped even after the cancellation.
+		next := 0
+		for res := range stream.Pipe(ctx, cancelling{h, "Roma500", cancel}, in, stream.Options{Workers: 8}) {
+			require.Equal(t, next, res.Index)
+			next++
+		}
+		cancel()
+	}
+}
@@ -43,23 +43,28 @@ func (b *Builder) Subwords() []Subword {
 
 	// Merge same level adjoin subwords.
 	var buf bytes.Buffer
+	var origins []Span
 	mergingLevel := -1
 
 	for _, sw := range b.subwords {
 		if sw.Level != mergingLevel && mergingLevel != -1 {
 			// Keep the merged sw.
 			merged := New(buf.String(), mergingLevel)
+			merged.Origins = origins
 			subwords = append(subwords, merged)
 
 			// Open a new one.
 			buf.Reset()
+			origins = nil
 		}
 
 		buf.WriteString(sw.Word)
+		origins = append(origins, sw.Origins...)
 		mergingLevel = sw.Level
 	}
 
 	merged := New(buf.String(), mergingLevel)
+	merged.Origins = origins
 	subwords = append(subwords, merged)
 
 	return subwords
@@ -3,7 +3,7 @@ package subword_test
 import (
 	"testing"
 
-	"github.com/hangulize/hangulize/internal/subword"
+	"github.com/hangulize/hangulize/pkg/subword"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,7 +17,7 @@ func TestBuilderEmpty(t *testing.T) {
 func TestBuilder1Subword(t *testing.T) {
 	var swBuf subword.Builder
 
-	swBuf.Write(subword.Subword{"hello", 1})
+	swBuf.Write(subword.New("hello", 1))
 
 	assert.Equal(t, "hello", swBuf.String())
 	assert.Len(t, swBuf.Subwords(), 1)
@@ -26,8 +26,8 @@ func TestBuilder1Subword(t *testing.T) {
 func TestBuilderMergeSameLevel(t *testing.T) {
 	var swBuf subword.Builder
 
-	swBuf.Write(subword.Subword{"hello", 1})
-	swBuf.Write(subword.Subword{"world", 1})
+	swBuf.Write(subword.New("hello", 1))
+	swBuf.Write(subword.New("world", 1))
 
 	assert.Equal(t, "helloworld", swBuf.String())
 	assert.Len(t, swBuf.Subwords(), 1)
@@ -36,8 +36,8 @@ func TestBuilderMergeSameLevel(t *testing.T) {
 func TestBuilderDifferentLevel(t *testing.T) {
 	var swBuf subword.Builder
 
-	swBuf.Write(subword.Subword{"hello", 1})
-	swBuf.Write(subword.Subword{"world", 2})
+	swBuf.Write(subword.New("hello", 1))
+	swBuf.Write(subword.New("world", 2))
 
 	assert.Equal(t, "helloworld", swBuf.String())
 	assert.Len(t, swBuf.Subwords(), 2)
@@ -0,0 +1,212 @@
+package subword
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Replacement is a deferred sw Replacement.
+type Replacement struct {
+	Start int
+	Stop  int
+	Word  string
+}
+
+func (r Replacement) String() string {
+	return fmt.Sprintf(`[%d-%d] %#v`, r.Start, r.Stop, r.Word)
+}
+
+// NewReplacement creates a Replacement.
+func NewReplacement(start, stop int, word string) Replacement {
+	return Replacement{start, stop, word}
+}
+
+// Replacer remembers replacements in a buffer. Finally, it applies the
+// replacements and splits the result in several subwords.
+type Replacer struct {
+	word string
+
+	// Buffered replacements.
+	repls []Replacement
+
+	levels []int
+
+	// The level for the replaced subwords.
+	nextLevel int
+
+	// origins are tracked only if Track has been called.
+	origins []Span
+
+	// history is recorded only if Record has been called.
+	recording bool
+	history   []Edit
+}
+
+// Edit is a batch of replacements committed at once, with the word after
+// the replacements.
+type Edit struct {
+	Replacements []Replacement
+	Word         string
+}
+
+// NewReplacer creates a SubwordReplacer for a word.
+func NewReplacer(word string, prevLevel, nextLevel int) *Replacer {
+	var repls []Replacement
+
+	levels := make([]int, len(word))
+	for i := 0; i < len(levels); i++ {
+		levels[i] = prevLevel
+	}
+
+	return &Replacer{word: word, repls: repls, levels: levels, nextLevel: nextLevel}
+}
+
+// Track makes the Replacer track the origin of each byte through the
+// replacements. The replaced bytes originate from the union of the origins of
+// the bytes they replaced. If origins is nil, each byte originates from
+// itself.
+func (r *Replacer) Track(origins []Span) *Replacer {
+	if origins == nil {
+		origins = make([]Span, len(r.word))
+		for i := range origins {
+			origins[i] = Span{i, i + 1}
+		}
+	}
+	r.origins = origins
+	return r
+}
+
+// Record makes the Replacer record the history of the replacements.
+func (r *Replacer) Record() *Replacer {
+	r.recording = true
+	return r
+}
+
+// History returns the committed batches of replacements in order. It is nil
+// unless Record has been called.
+func (r *Replacer) History() []Edit {
+	return r.history
+}
+
+// replacedOrigin finds the origin of the bytes replacing word[start:stop].
+// An insertion originates from the empty span at the insertion point.
+func (r *Replacer) replacedOrigin(start, stop int) Span {
+	if start == stop {
+		switch {
+		case start < len(r.origins):
+			return Span{r.origins[start].Start, r.origins[start].Start}
+		case start > 0:
+			return Span{r.origins[start-1].Stop, r.origins[start-1].Stop}
+		}
+		return Span{}
+	}
+
+	span := r.origins[start]
+	for _, origin := range r.origins[start+1 : stop] {
+		span = span.Union(origin)
+	}
+	return span
+}
+
+// Replace buffers a replacement.
+func (r *Replacer) Replace(start, stop int, word string) {
+	r.ReplaceBy(NewReplacement(start, stop, word))
+}
+
+// ReplaceBy buffers multiple replacements.
+func (r *Replacer) ReplaceBy(repls ...Replacement) {
+	r.repls = append(r.repls, repls...)
+}
+
+// commit applies the buffered replacements to the SubwordReplacer internal.
+func (r *Replacer) commit() {
+	var buf bytes.Buffer
+	var levels []int
+	var origins []Span
+
+	tracking := r.origins != nil
+
+	offset := 0
+	for _, repl := range r.repls {
+		start := repl.Start
+		stop := repl.Stop
+		word := repl.Word
+
+		// before replacement
+		buf.WriteString(r.word[offset:start])
+		levels = append(levels, r.levels[offset:start]...)
+
+		// replacement
+		buf.WriteString(word)
+		for i := 0; i < len(word); i++ {
+			levels = append(levels, r.nextLevel)
+		}
+
+		if tracking {
+			origins = append(origins, r.origins[offset:start]...)
+			origins = append(origins, Fill(word, r.replacedOrigin(start, stop))...)
+		}
+
+		offset = stop
+	}
+	// after replacement
+	buf.WriteString(r.word[offset:])
+	levels = append(levels, r.levels[offset:]...)
+
+	r.word = buf.String()
+	r.levels = levels
+
+	if r.recording && len(r.repls) != 0 {
+		r.history = append(r.history, Edit{r.repls, r.word})
+	}
+	r.repls = make([]Replacement, 0)
+
+	if tracking {
+		r.origins = append(origins, r.origins[offset:]...)
+	}
+}
+
+// String applies the buffered replacements and returns the replaced full word.
+func (r *Replacer) String() string {
+	r.commit()
+	return r.word
+}
+
+// Subwords applies the buffered replacements and returns the replaced word as
+// a []Subword array.
+func (r *Replacer) Subwords() []Subword {
+	r.commit()
+
+	var subwords []Subword
+
+	if len(r.levels) == 0 {
+		return subwords
+	}
+
+	level := r.levels[0]
+	start := 0
+
+	var buf bytes.Buffer
+
+	for i, ch := range r.word {
+		if r.levels[i] != level {
+			subwords = append(subwords, r.subword(buf.String(), level, start))
+			level = r.levels[i]
+			start = i
+			buf.Reset()
+		}
+		buf.WriteRune(ch)
+	}
+	subwords = append(subwords, r.subword(buf.String(), level, start))
+
+	return subwords
+}
+
+// subword creates a Subword at the offset with the tracked origins.
+func (r *Replacer) subword(word string, level, offset int) Subword {
+	sw := New(word, level)
+	if r.origins != nil {
+		sw.Origins = r.origins[offset : offset+len(word)]
+	}
+	return sw
+}
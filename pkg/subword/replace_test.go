@@ -0,0 +1,59 @@
+package subword_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize/pkg/subword"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplacer(t *testing.T) {
+	replacer := subword.NewReplacer("Hello, world", 0, 1)
+	replacer.Replace(0, 5, "Bye")
+	sws := replacer.Subwords()
+
+	require.Len(t, sws, 2)
+	assert.Equal(t, subword.New("Bye", 1), sws[0])
+	assert.Equal(t, subword.New(", world", 0), sws[1])
+}
+
+func TestReplacerTrack(t *testing.T) {
+	// "axe" -> "akse" -> "ㅇㅐㄱ..."
+	replacer := subword.NewReplacer("axe", 0, 1).Track(nil)
+	replacer.Replace(1, 2, "ks")
+	replacer.Replace(3, 3, "!")
+	sws := replacer.Subwords()
+
+	require.Len(t, sws, 4)
+	assert.Equal(t, []subword.Span{{0, 1}}, sws[0].Origins)
+	assert.Equal(t, []subword.Span{{1, 2}, {1, 2}}, sws[1].Origins)
+	assert.Equal(t, []subword.Span{{2, 3}}, sws[2].Origins)
+	assert.Equal(t, []subword.Span{{3, 3}}, sws[3].Origins)
+
+	// The origins are carried through the next replacements.
+	replacer = subword.NewReplacer("akse", 1, 2).Track(subword.Fill("akse", subword.Span{Start: 5, Stop: 8}))
+	replacer.Replace(0, 2, "X")
+	sws = replacer.Subwords()
+
+	require.Len(t, sws, 2)
+	assert.Equal(t, []subword.Span{{5, 8}}, sws[0].Origins)
+}
+
+func TestReplacerRecord(t *testing.T) {
+	replacer := subword.NewReplacer("axe", 0, 1).Record()
+	replacer.Replace(1, 2, "ks")
+	assert.Equal(t, "akse", replacer.String())
+
+	// Nothing to commit.
+	assert.Equal(t, "akse", replacer.String())
+
+	replacer.Replace(0, 1, "ㅐ")
+	replacer.Replace(4, 4, "!")
+	replacer.Subwords()
+
+	assert.Equal(t, []subword.Edit{
+		{[]subword.Replacement{subword.NewReplacement(1, 2, "ks")}, "akse"},
+		{[]subword.Replacement{subword.NewReplacement(0, 1, "ㅐ"), subword.NewReplacement(4, 4, "!")}, "ㅐkse!"},
+	}, replacer.History())
+}
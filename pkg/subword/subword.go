@@ -0,0 +1,58 @@
+/*
+Package subword implements a word replacement with a level. It is the exact
+machinery which the Hangulize procedure uses to rewrite and transcribe words,
+so external tools such as spec debuggers or diff viewers can reuse it:
+
+	r := subword.NewReplacer("axe", 0, 1).Track(nil).Record()
+	r.Replace(1, 2, "ks")
+	r.Subwords() // [{"a" 0} {"ks" 1} {"e" 0}]
+
+	r.History() // [{[[1-2] "ks"] "akse"}]
+
+The level of a subword tells which step has generated it. Track tracks the
+span in the original word where each byte came from, and Record records the
+replacements committed so far.
+*/
+package subword
+
+// Subword is a chunk of a word with a level number. The level indicates which
+// step in the procedure generated this sw.
+type Subword struct {
+	Word  string
+	Level int
+
+	// Origins are the spans in the original word where each byte of the
+	// Word came from. It is nil unless a tracking Replacer generated it.
+	Origins []Span
+}
+
+// New creates a Subword.
+func New(word string, level int) Subword {
+	return Subword{Word: word, Level: level}
+}
+
+// Span is a range of bytes in the original word.
+type Span struct {
+	Start int
+	Stop  int
+}
+
+// Union returns the smallest span which covers both of the spans.
+func (s Span) Union(other Span) Span {
+	if other.Start < s.Start {
+		s.Start = other.Start
+	}
+	if other.Stop > s.Stop {
+		s.Stop = other.Stop
+	}
+	return s
+}
+
+// Fill creates the origins of a word which came from a span as a whole.
+func Fill(word string, span Span) []Span {
+	origins := make([]Span, len(word))
+	for i := range origins {
+		origins[i] = span
+	}
+	return origins
+}
@@ -3,11 +3,13 @@ package hangulize
 import (
 	"bytes"
 	"fmt"
+	"log/slog"
 	"strings"
 	"unicode"
 
 	"github.com/hangulize/hangulize/internal/jamo"
-	"github.com/hangulize/hangulize/internal/subword"
+	"github.com/hangulize/hangulize/pkg/subword"
+	"golang.org/x/text/unicode/norm"
 )
 
 // procedure implements the Hangulize procedure.
@@ -15,15 +17,129 @@ type procedure struct {
 	spec      *Spec
 	translits map[string]Translit
 	tracer    *tracer
+
+	// lenientTranslit skips missing Translits.
+	lenientTranslit bool
+
+	// koreanTypography tidies up the result.
+	koreanTypography bool
+
+	// joinNames joins the components of a name with a middle dot.
+	joinNames bool
+
+	// syllableSep and groupSep are inserted between the syllables and the
+	// groups split by ZWSP.
+	syllableSep string
+	groupSep    string
+
+	// epentheticVowels overrides "ㅡ" after a consonant without a vowel.
+	epentheticVowels map[rune]rune
+
+	// finals is the policy for the final consonants.
+	finals FinalPolicy
+
+	// longVowels is the policy for the long vowels.
+	longVowels LongVowelPolicy
+
+	// hyphens and apostrophes are the policies for the joints in names.
+	hyphens     JointPolicy
+	apostrophes JointPolicy
+
+	// track tracks the origins of the subwords for the alignment.
+	track bool
+
+	budget *budget
+	logger *slog.Logger
 }
 
 // newProcedure creates a new procedure.
 func newProcedure(spec *Spec, translits map[string]Translit, traceFunc func(Trace)) *procedure {
-	return &procedure{spec, translits, newTracer(traceFunc)}
+	return &procedure{spec: spec, translits: translits, tracer: newTracer(traceFunc)}
 }
 
 // forward runs the Hangulize procedure for a word.
+//
+// The word is split at the hyphens and apostrophes by the policies first.
+// Then the phrases in the phrase dictionary of the spec are matched before
+// anything else. They are replaced with the results in the dictionary and
+// the rest of the word runs the procedure.
 func (p procedure) forward(word string) (string, error) {
+	var buf strings.Builder
+
+	for _, tok := range p.tokenize(word) {
+		result, err := p.forwardPhrases(tok.word)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(result)
+		buf.WriteString(tok.joint)
+	}
+
+	word = buf.String()
+	if p.koreanTypography {
+		word = typesetKorean(word)
+	}
+
+	if err := p.budget.Err(); err != nil {
+		logAttrs(p.logger, slog.LevelWarn, err.Error(),
+			slog.String("lang", p.spec.Lang.ID),
+			slog.String("word", word),
+		)
+		return word, err
+	}
+	return word, nil
+}
+
+// forwardPhrases replaces the phrases in a word with the results in the
+// phrase dictionary and runs the procedure for the rest.
+func (p procedure) forwardPhrases(word string) (string, error) {
+	if len(p.spec.phrases) != 0 {
+		word = norm.NFC.String(word)
+	}
+	matches := matchPhrases(p.spec.phrases, word)
+
+	var pieces []string
+	pos := 0
+
+	for _, m := range append(matches, phraseMatch{len(word), len(word), ""}) {
+		if pos < m.start {
+			gap := word[pos:m.start]
+
+			// With JoinNames, the spaces next to a phrase are kept apart to
+			// join the phrase with the rest.
+			var lead, trail string
+			if p.joinNames && pos != 0 {
+				trimmed := strings.TrimLeftFunc(gap, unicode.IsSpace)
+				lead, gap = gap[:len(gap)-len(trimmed)], trimmed
+			}
+			if p.joinNames && m.start != len(word) {
+				trimmed := strings.TrimRightFunc(gap, unicode.IsSpace)
+				gap, trail = trimmed, gap[len(trimmed):]
+			}
+
+			pieces = append(pieces, lead)
+			if gap != "" {
+				result, err := p.forwardWord(gap)
+				if err != nil {
+					return "", err
+				}
+				pieces = append(pieces, result)
+			}
+			pieces = append(pieces, trail)
+		}
+
+		pieces = append(pieces, m.result)
+		pos = m.stop
+	}
+
+	if p.joinNames {
+		return joinNamePieces(pieces), nil
+	}
+	return strings.Join(pieces, ""), nil
+}
+
+// forwardWord runs the Hangulize procedure for a word without phrases.
+func (p procedure) forwardWord(word string) (string, error) {
 	p.tracer.Input(word)
 
 	// phase: preparing
@@ -32,11 +148,15 @@ func (p procedure) forward(word string) (string, error) {
 		return "", err
 	}
 	word = p.normalize(word)
+	word, _ = p.stripStress(word)
 
 	// phase: transcribing
 	subwords := p.partition(word)
 	subwords = p.rewrite(subwords)
 	subwords = p.transcribe(subwords)
+	subwords = p.applyLongVowels(subwords, word)
+	subwords = p.applyFinals(subwords, word)
+	subwords = p.joinNameComponents(subwords)
 
 	// phase: finalizing
 	word = p.syllabify(subwords)
@@ -51,17 +171,41 @@ func (p procedure) forward(word string) (string, error) {
 // phonograms, usually based on lexical analysis. Most languages already use
 // phonograms which are sufficient to represent the exact pronunciation. But in
 // some languages, such as American English or Chinese, it's not true.
+//
+// The optional Translits of the spec run first if they are imported.
 func (p procedure) transliterate(word string) (string, error) {
+	for _, scheme := range p.spec.Lang.OptionalTranslit {
+		t, ok := p.translits[scheme]
+		if !ok {
+			continue
+		}
+
+		var err error
+		word, err = t.Transliterate(word)
+		if err != nil {
+			return word, fmt.Errorf("%w: %s: %w", ErrTranslit, scheme, err)
+		}
+
+		p.tracer.Transliterate(word, t.Scheme())
+	}
+
 	for _, scheme := range p.spec.Lang.Translit {
 		t, ok := p.translits[scheme]
 		if !ok {
-			return word, fmt.Errorf("%w: %s", ErrTranslitNotImported, scheme)
+			if p.lenientTranslit {
+				logAttrs(p.logger, slog.LevelWarn, "translit missing; skipped",
+					slog.String("lang", p.spec.Lang.ID),
+					slog.String("scheme", scheme),
+				)
+				continue
+			}
+			return word, fmt.Errorf("%w: %s", ErrTranslitMissing, scheme)
 		}
 
 		var err error
 		word, err = t.Transliterate(word)
 		if err != nil {
-			return word, fmt.Errorf("%w: %s", ErrTranslit, scheme)
+			return word, fmt.Errorf("%w: %s: %w", ErrTranslit, scheme, err)
 		}
 
 		p.tracer.Transliterate(word, t.Scheme())
@@ -81,6 +225,14 @@ func (p procedure) normalize(word string) string {
 	p.tracer.Normalize(word, "")
 
 	// Per-script normalization.
+	word = p.normalizeScript(word)
+	p.tracer.Normalize(word, p.spec.Lang.Script)
+	return word
+}
+
+// normalizeScript normalizes the letters in the script of the spec except
+// the letters which the spec keeps.
+func (p procedure) normalizeScript(word string) string {
 	script := p.spec.script
 	except := p.spec.normLetters
 
@@ -94,9 +246,7 @@ func (p procedure) normalize(word string) string {
 		}
 	}
 
-	word = buf.String()
-	p.tracer.Normalize(word, p.spec.Lang.Script)
-	return word
+	return buf.String()
 }
 
 // 3. Partition (Word -> Subwords[level=0 or 1])
@@ -109,6 +259,9 @@ func (p procedure) normalize(word string) string {
 // [{"hello",1}, {", ",0}, {"world",1}, {"!",0}].
 func (p procedure) partition(word string) []subword.Subword {
 	rep := subword.NewReplacer(word, 0, 1)
+	if p.track {
+		rep.Track(nil)
+	}
 
 	for i, let := range word {
 		letStr := string(let)
@@ -143,11 +296,13 @@ func (p procedure) rewrite(subwords []subword.Subword) []subword.Subword {
 
 	for i, sw := range subwords {
 		word := sw.Word
-		level := sw.Level
-
-		rep := subword.NewReplacer(word, level, 1)
+		rep := p.newReplacer(sw, 1)
 
 		for _, rule := range p.spec.Rewrite {
+			if !p.budget.spend() {
+				break
+			}
+
 			repls := rule.replacements(word)
 			rep.ReplaceBy(repls...)
 			word = rep.String()
@@ -183,9 +338,7 @@ func (p procedure) transcribe(subwords []subword.Subword) []subword.Subword {
 		}
 
 		word := sw.Word
-		level := sw.Level
-
-		rep := subword.NewReplacer(word, level, 2)
+		rep := p.newReplacer(sw, 2)
 
 		// transcribe is not rewrite. A result of a replacement is not the
 		// input of the next replacement. dummy masks the replaced subwords
@@ -193,6 +346,10 @@ func (p procedure) transcribe(subwords []subword.Subword) []subword.Subword {
 		dummy := subword.NewReplacer(word, 0, 0)
 
 		for _, rule := range p.spec.Transcribe {
+			if !p.budget.spend() {
+				break
+			}
+
 			repls := rule.replacements(word)
 			rep.ReplaceBy(repls...)
 
@@ -215,8 +372,23 @@ func (p procedure) transcribe(subwords []subword.Subword) []subword.Subword {
 
 	for _, sw := range subwords {
 		if sw.Level == 1 {
-			if hasSpace(sw.Word) {
-				swBuf.Write(subword.New(" ", 1))
+			if span := strings.TrimSpace(sw.Word); span != "" {
+				logAttrs(p.logger, slog.LevelInfo, "untranscribed",
+					slog.String("lang", p.spec.Lang.ID),
+					slog.String("span", span),
+				)
+			}
+			// Keep the original spaces as they are. But the spaces around
+			// untranscribed letters become a space.
+			switch {
+			case hasSpaceOnly(sw.Word):
+				swBuf.Write(sw)
+			case hasSpace(sw.Word):
+				space := subword.New(" ", 1)
+				if p.track {
+					space.Origins = subword.Fill(" ", spanOf(sw.Origins))
+				}
+				swBuf.Write(space)
 			}
 			continue
 		}
@@ -226,6 +398,16 @@ func (p procedure) transcribe(subwords []subword.Subword) []subword.Subword {
 	return swBuf.Subwords()
 }
 
+// newReplacer creates a subword.Replacer for a subword. It tracks the origins
+// if the alignment is required.
+func (p procedure) newReplacer(sw subword.Subword, nextLevel int) *subword.Replacer {
+	rep := subword.NewReplacer(sw.Word, sw.Level, nextLevel)
+	if p.track {
+		rep.Track(sw.Origins)
+	}
+	return rep
+}
+
 // 6. Syllabify (Subwords -> Word)
 //
 // This step converts decomposed Jamo phonemes to composed Hangul syllables.
@@ -239,7 +421,7 @@ func (p procedure) syllabify(subwords []subword.Subword) string {
 		// Don't touch level=0 subwords. They just have passed through the
 		// procedure, because they are meaningless.
 		if sw.Level == 0 {
-			buf.WriteString(jamo.ComposeHangul(jamoBuf.String()))
+			buf.WriteString(p.composeHangul(jamoBuf.String()))
 			jamoBuf.Reset()
 
 			buf.WriteString(sw.Word)
@@ -247,13 +429,28 @@ func (p procedure) syllabify(subwords []subword.Subword) string {
 		}
 		jamoBuf.WriteString(sw.Word)
 	}
-	buf.WriteString(jamo.ComposeHangul(jamoBuf.String()))
+	buf.WriteString(p.composeHangul(jamoBuf.String()))
 
 	word := buf.String()
 	p.tracer.Syllabify(word)
 	return word
 }
 
+// composeHangul composes Jamo phonemes into Hangul syllables separated by the
+// syllable separator.
+func (p procedure) composeHangul(word string) string {
+	word, _ = p.epenthesize(word, nil)
+	return separateSyllables(jamo.ComposeHangul(word, p.jamoOptions()...), p.syllableSep)
+}
+
+// jamoOptions are the options to compose Jamo phonemes by the spec.
+func (p procedure) jamoOptions() []jamo.Option {
+	if p.spec.Config.SmartAttach {
+		return nil
+	}
+	return []jamo.Option{jamo.WithSmartAttach(false)}
+}
+
 // 7. Localize (Word -> Word)
 //
 // Finally, this step converts foreign punctuations to fit in Korean.
@@ -282,8 +479,11 @@ func (p procedure) localize(word string) string {
 	var buf bytes.Buffer
 
 	for i, ch := range chars {
-		// Skip ZWSP.
+		// Skip ZWSP or replace it with the group separator.
 		if ch == '\u200B' {
+			if p.groupSep != "" && !isSpace[i-1] && !isSpace[i+1] && chars[i+1] != '\u200B' {
+				buf.WriteString(p.groupSep)
+			}
 			continue
 		}
 
@@ -0,0 +1,202 @@
+package hangulize
+
+import (
+	"runtime"
+	"time"
+)
+
+// Report is a debug bundle of a transcription. It contains everything to
+// reproduce the transcription so that it can be attached to a bug report
+// against a spec or the engine as JSON.
+type Report struct {
+	// Input is the word and Result is the transcription. Error is the error
+	// of the transcription, if any.
+	Input  string `json:"input"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+
+	// Exception is true if the result is an exception word of a bundle
+	// rather than a transcription by the rules.
+	Exception bool `json:"exception,omitempty"`
+
+	// Options are the options of the Hangulizer.
+	Options ReportOptions `json:"options"`
+
+	// Version is the version of Hangulize.
+	Version string `json:"version"`
+
+	// Lang and Checksum identify the exact revision of the spec. Modified is
+	// true if the rules have been modified at runtime.
+	Lang     string `json:"lang"`
+	Checksum string `json:"checksum"`
+	Modified bool   `json:"modified,omitempty"`
+
+	// Datasets are the datasets which the Translits rely on.
+	Datasets []Dataset `json:"datasets,omitempty"`
+
+	// Traces are the full traces of the transcription.
+	Traces []ReportTrace `json:"traces"`
+
+	// Env is the environment where the report has been generated.
+	Env ReportEnv `json:"env"`
+}
+
+// ReportOptions are the options of a Hangulizer in a Report. The zero value
+// of each field means the default.
+type ReportOptions struct {
+	LenientTranslit   bool   `json:"lenientTranslit,omitempty"`
+	KoreanTypography  bool   `json:"koreanTypography,omitempty"`
+	JoinNames         bool   `json:"joinNames,omitempty"`
+	SyllableSeparator string `json:"syllableSeparator,omitempty"`
+	GroupSeparator    string `json:"groupSeparator,omitempty"`
+
+	// EpentheticVowels are keyed by the consonants. The empty key is for
+	// the other consonants.
+	EpentheticVowels map[string]string `json:"epentheticVowels,omitempty"`
+
+	Finals      FinalPolicy     `json:"finals,omitempty"`
+	LongVowels  LongVowelPolicy `json:"longVowels,omitempty"`
+	Hyphens     JointPolicy     `json:"hyphens,omitempty"`
+	Apostrophes JointPolicy     `json:"apostrophes,omitempty"`
+
+	// StepBudget and TimeBudget are negative if unlimited.
+	StepBudget int           `json:"stepBudget,omitempty"`
+	TimeBudget time.Duration `json:"timeBudget,omitempty"`
+}
+
+// ReportTrace is a Trace in a Report.
+type ReportTrace struct {
+	Step string `json:"step"`
+	Word string `json:"word"`
+	Why  string `json:"why,omitempty"`
+	Rule string `json:"rule,omitempty"`
+}
+
+// ReportEnv is the environment in a Report.
+type ReportEnv struct {
+	Go   string `json:"go"`
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+// NewReport transcribes a word and reports it. The error of the
+// transcription is reported in the Report rather than returned.
+//
+// The options and the exceptions are reported only for the Hangulizers
+// created by this package, such as by New or Bundle.Hangulizer. The other
+// implementations are reported with their Spec and Translits only.
+func NewReport(h Hangulizer, word string) *Report {
+	r := &Report{
+		Input: word,
+		Env:   ReportEnv{runtime.Version(), runtime.GOOS, runtime.GOARCH},
+	}
+
+	prov := ProvenanceOf(h)
+	r.Version = prov.Version
+	r.Lang = prov.Lang
+	r.Checksum = prov.Checksum
+	r.Modified = prov.Modified
+	r.Datasets = prov.Datasets
+
+	traceFunc := func(t Trace) {
+		rt := ReportTrace{Step: t.Step, Word: t.Word, Why: t.Why}
+		if t.Rule != nil {
+			rt.Rule = t.Rule.String()
+		}
+		r.Traces = append(r.Traces, rt)
+	}
+
+	var p *procedure
+	if in, ok := h.(introspectable); ok {
+		r.Options = reportOptions(in.effectiveOptions())
+
+		if result, ok := in.exception(word); ok {
+			r.Result = result
+			r.Exception = true
+			return r
+		}
+
+		p = in.procedure()
+		p.tracer = newTracer(traceFunc)
+	} else {
+		p = newProcedure(h.Spec(), h.Translits(), traceFunc)
+	}
+
+	result, err := p.forward(word)
+	r.Result = result
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+// reportOptions converts options into ReportOptions.
+func reportOptions(o options) ReportOptions {
+	ro := ReportOptions{
+		LenientTranslit:   o.lenientTranslit,
+		KoreanTypography:  o.koreanTypography,
+		JoinNames:         o.joinNames,
+		SyllableSeparator: o.syllableSep,
+		GroupSeparator:    o.groupSep,
+		Finals:            o.finals,
+		LongVowels:        o.longVowels,
+		Hyphens:           o.hyphens,
+		Apostrophes:       o.apostrophes,
+		StepBudget:        o.stepBudget,
+		TimeBudget:        o.timeBudget,
+	}
+
+	if o.epentheticVowels != nil {
+		ro.EpentheticVowels = make(map[string]string, len(o.epentheticVowels))
+		for cons, vowel := range o.epentheticVowels {
+			key := ""
+			if cons != 0 {
+				key = string(cons)
+			}
+			ro.EpentheticVowels[key] = string(vowel)
+		}
+	}
+
+	return ro
+}
+
+// Options converts ReportOptions back into Options to reproduce the
+// transcription:
+//
+//	spec, _ := hangulize.LoadSpec(r.Lang)
+//	h := hangulize.New(spec, r.Options.Options()...)
+func (ro ReportOptions) Options() []Option {
+	opts := []Option{
+		LenientTranslit(ro.LenientTranslit),
+		KoreanTypography(ro.KoreanTypography),
+		JoinNames(ro.JoinNames),
+		SyllableSeparator(ro.SyllableSeparator),
+		GroupSeparator(ro.GroupSeparator),
+		Finals(ro.Finals),
+		LongVowels(ro.LongVowels),
+		Hyphens(ro.Hyphens),
+		Apostrophes(ro.Apostrophes),
+	}
+
+	if ro.EpentheticVowels != nil {
+		vowels := make(map[rune]rune, len(ro.EpentheticVowels))
+		for cons, vowel := range ro.EpentheticVowels {
+			var key rune
+			for _, ch := range cons {
+				key = ch
+			}
+			for _, ch := range vowel {
+				vowels[key] = ch
+			}
+		}
+		opts = append(opts, EpentheticVowels(vowels))
+	}
+
+	if ro.StepBudget != 0 {
+		opts = append(opts, StepBudget(ro.StepBudget))
+	}
+	if ro.TimeBudget != 0 {
+		opts = append(opts, TimeBudget(ro.TimeBudget))
+	}
+	return opts
+}
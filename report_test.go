@@ -0,0 +1,63 @@
+package hangulize_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	spec := loadSpec("deu")
+	h := hangulize.New(spec,
+		hangulize.JoinNames(true),
+		hangulize.EpentheticVowels(map[rune]rune{0: 'ㅜ', 'ㅎ': 'ㅣ'}),
+		hangulize.StepBudget(-1),
+	)
+
+	r := hangulize.NewReport(h, "Johann Sebastian Bach")
+	assert.Equal(t, "요한·제바수티안·바히", r.Result)
+	assert.Empty(t, r.Error)
+	assert.Equal(t, "deu", r.Lang)
+	assert.Equal(t, spec.Checksum(), r.Checksum)
+	assert.Equal(t, "Input", r.Traces[0].Step)
+	assert.NotEmpty(t, r.Env.Go)
+
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+
+	var loaded hangulize.Report
+	require.NoError(t, json.Unmarshal(data, &loaded))
+	assert.Equal(t, *r, loaded)
+
+	// Reproduce the transcription.
+	reproduced, err := hangulize.New(loadSpec(loaded.Lang), loaded.Options.Options()...).Hangulize(loaded.Input)
+	require.NoError(t, err)
+	assert.Equal(t, r.Result, reproduced)
+}
+
+func TestReportError(t *testing.T) {
+	// The Translit for jpn has not been installed.
+	r := hangulize.NewReport(hangulize.New(loadSpec("jpn")), "東京")
+	assert.Contains(t, r.Error, "translit")
+}
+
+func TestReportBundle(t *testing.T) {
+	b := &hangulize.Bundle{
+		Spec:       mustParseSpec(bundleHSL),
+		Exceptions: map[string]string{"bab": "밥"},
+	}
+	h := b.Hangulizer().With(hangulize.SyllableSeparator("·"))
+
+	r := hangulize.NewReport(h, "bab")
+	assert.Equal(t, "밥", r.Result)
+	assert.True(t, r.Exception)
+	assert.Equal(t, "·", r.Options.SyllableSeparator)
+
+	r = hangulize.NewReport(h, "ab")
+	assert.Equal(t, "아·브", r.Result)
+	assert.False(t, r.Exception)
+	assert.Equal(t, "·", r.Options.SyllableSeparator)
+}
@@ -3,8 +3,8 @@ package hangulize
 import (
 	"fmt"
 
-	"github.com/hangulize/hangulize/internal/subword"
 	"github.com/hangulize/hangulize/pkg/hre"
+	"github.com/hangulize/hangulize/pkg/subword"
 )
 
 // Rule is a pair of Pattern and RPattern.
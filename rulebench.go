@@ -0,0 +1,163 @@
+package hangulize
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hangulize/hangulize/pkg/hre"
+)
+
+// Thresholds of pathological rules.
+const (
+	// maxAlternatives is the number of alternatives in an alternation which
+	// is regarded as huge.
+	maxAlternatives = 64
+
+	// slowFactor is how many times slower than the median rule a slow rule is.
+	slowFactor = 10
+
+	// benchRounds is the number of rounds to measure a rule.
+	benchRounds = 5
+)
+
+// RuleBench is the performance of a rule measured by BenchmarkRules.
+type RuleBench struct {
+	// Section is "rewrite" or "transcribe".
+	Section string
+
+	Rule *Rule
+
+	// Duration is the average time to apply the rule to an input.
+	Duration time.Duration
+
+	Complexity hre.Complexity
+
+	// Warnings describe the pathological behaviors of the rule.
+	Warnings []string
+}
+
+func (b RuleBench) String() string {
+	s := fmt.Sprintf("%s: %s: %s/op", b.Section, b.Rule, b.Duration)
+	if len(b.Warnings) != 0 {
+		s += ": " + strings.Join(b.Warnings, ", ")
+	}
+	return s
+}
+
+// BenchmarkRules measures each rule in a spec against representative inputs:
+// the test words in the spec and the examples of the patterns. Each rule runs
+// repeatedly for about d and the best round is taken.
+//
+// A rule gets warnings if its pattern has a huge alternation or starts with
+// an unbounded repetition, or if it is much slower than the median rule.
+func BenchmarkRules(spec *Spec, d time.Duration) []RuleBench {
+	inputs := benchInputs(spec)
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	var benches []RuleBench
+
+	measure := func(section string, rules []Rule, apply func(Rule, string)) {
+		for i := range rules {
+			rule := &rules[i]
+
+			// Take the best of a few rounds to filter out noises such as
+			// garbage collection.
+			var best time.Duration
+			for round := 0; round < benchRounds; round++ {
+				n := 0
+				start := time.Now()
+				for time.Since(start) < d/benchRounds || n == 0 {
+					for _, word := range inputs {
+						apply(*rule, word)
+					}
+					n++
+				}
+				perOp := time.Since(start) / time.Duration(n*len(inputs))
+
+				if round == 0 || perOp < best {
+					best = perOp
+				}
+			}
+
+			benches = append(benches, RuleBench{
+				Section:    section,
+				Rule:       rule,
+				Duration:   best,
+				Complexity: rule.From.Complexity(),
+			})
+		}
+	}
+
+	measure("rewrite", spec.Rewrite, func(r Rule, word string) {
+		r.Replace(word)
+	})
+	measure("transcribe", spec.Transcribe, func(r Rule, word string) {
+		r.replacements(word)
+	})
+
+	median := medianDuration(benches)
+
+	for i := range benches {
+		b := &benches[i]
+
+		if n := b.Complexity.Alternatives; n > maxAlternatives {
+			b.Warnings = append(b.Warnings,
+				fmt.Sprintf("huge alternation of %d alternatives", n))
+		}
+		if b.Complexity.UnboundedPrefix {
+			b.Warnings = append(b.Warnings,
+				"starts with an unbounded repetition")
+		}
+		if median != 0 && b.Duration > median*slowFactor {
+			b.Warnings = append(b.Warnings,
+				fmt.Sprintf("%.0fx slower than the median", float64(b.Duration)/float64(median)))
+		}
+	}
+
+	return benches
+}
+
+// benchInputs collects the representative inputs of a spec.
+func benchInputs(spec *Spec) []string {
+	seen := make(map[string]bool)
+	var inputs []string
+
+	add := func(word string) {
+		if word != "" && !seen[word] {
+			seen[word] = true
+			inputs = append(inputs, word)
+		}
+	}
+
+	for _, exm := range spec.Test {
+		add(strings.ToLower(exm[0]))
+	}
+	for _, rules := range [][]Rule{spec.Rewrite, spec.Transcribe} {
+		for _, rule := range rules {
+			for _, word := range rule.From.Examples(conflictExamples) {
+				add(word)
+			}
+		}
+	}
+
+	return inputs
+}
+
+func medianDuration(benches []RuleBench) time.Duration {
+	if len(benches) == 0 {
+		return 0
+	}
+
+	durations := make([]time.Duration, len(benches))
+	for i, b := range benches {
+		durations[i] = b.Duration
+	}
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+	return durations[len(durations)/2]
+}
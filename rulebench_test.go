@@ -0,0 +1,51 @@
+package hangulize_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkRules(t *testing.T) {
+	var words []string
+	for i := 0; i < 100; i++ {
+		words = append(words, fmt.Sprintf(`"%cyz"`, 0x100+i))
+	}
+
+	spec := mustParseSpec(`
+lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+vars:
+    "words" = ` + strings.Join(words, ", ") + `
+
+rewrite:
+    "<words>" -> "w"
+    "a+b"     -> "ab"
+
+transcribe:
+    "a" -> "ㅏ"
+    "b" -> "ㅂ"
+
+test:
+    "aab" -> "아브"
+`)
+
+	benches := hangulize.BenchmarkRules(spec, time.Millisecond)
+	require.Len(t, benches, 4)
+
+	assert.Equal(t, "rewrite", benches[0].Section)
+	assert.Contains(t, benches[0].Warnings, "huge alternation of 100 alternatives")
+	assert.Contains(t, benches[1].Warnings, "starts with an unbounded repetition")
+
+	for _, b := range benches {
+		assert.NotZero(t, b.Duration, b.Rule.String())
+	}
+}
@@ -0,0 +1,55 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SyllableSeparator makes a Hangulizer insert a separator, such as "·" or
+// ".", between the Hangul syllables in the result: "카.푸.치.노". It is for
+// phonological studies which need the syllable boundaries explicitly.
+func SyllableSeparator(sep string) Option {
+	return func(o *options) {
+		o.syllableSep = sep
+	}
+}
+
+// GroupSeparator makes a Hangulizer insert a separator between the groups of
+// syllables. A group is the result of a chunk which a Translit has split by
+// a ZWSP, such as a Pinyin syllable or a morpheme. The ZWSPs are removed
+// without this option.
+//
+// With SyllableSeparator, the group separator replaces the syllable
+// separator at the group boundaries.
+func GroupSeparator(sep string) Option {
+	return func(o *options) {
+		o.groupSep = sep
+	}
+}
+
+// separateSyllables inserts a separator between the adjacent Hangul
+// syllables in a word.
+func separateSyllables(word, sep string) string {
+	if sep == "" {
+		return word
+	}
+
+	var buf strings.Builder
+
+	prev := rune(0)
+	for _, ch := range word {
+		if isHangulSyllable(prev) && isHangulSyllable(ch) {
+			buf.WriteString(sep)
+		}
+		buf.WriteRune(ch)
+		prev = ch
+	}
+
+	return buf.String()
+}
+
+// isHangulSyllable reports whether a letter is a composed Hangul syllable
+// such as "한".
+func isHangulSyllable(ch rune) bool {
+	return ch >= 0xAC00 && ch <= 0xD7A3 && unicode.Is(unicode.Hangul, ch)
+}
@@ -0,0 +1,38 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyllableSeparator(t *testing.T) {
+	h := hangulize.New(loadSpec("ita"), hangulize.SyllableSeparator("."))
+
+	for word, expected := range map[string]string{
+		"Cappuccino":        "카.푸.치.노",
+		"Cappuccino gelato": "카.푸.치.노 젤.라.토",
+		"Roma!":             "로.마!",
+	} {
+		result, err := h.Hangulize(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+}
+
+func TestGroupSeparator(t *testing.T) {
+	h := hangulize.New(loadSpec("chi"), hangulize.GroupSeparator("|"))
+	translit.Install(h)
+
+	result, err := h.Hangulize("北京 上海")
+	require.NoError(t, err)
+	assert.Equal(t, "베이|징 상|하이", result)
+
+	h = h.With(hangulize.SyllableSeparator("."))
+	result, err = h.Hangulize("北京")
+	require.NoError(t, err)
+	assert.Equal(t, "베.이|징", result)
+}
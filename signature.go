@@ -0,0 +1,121 @@
+package hangulize
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+)
+
+// SignatureExt is the file extension of a detached signature. The signature
+// of "ita.hsl" is "ita.hsl.sig".
+const SignatureExt = ".sig"
+
+// Trust is a policy to accept external specs and bundles. A spec or bundle is
+// accepted only if it has been signed by one of the trusted keys.
+type Trust struct {
+	// Keys are the trusted ed25519 public keys.
+	Keys []ed25519.PublicKey
+
+	// AllowUnsigned accepts an unsigned source. But a signed source with a
+	// bad signature is still refused.
+	AllowUnsigned bool
+}
+
+// Sign signs a source with an ed25519 private key. The signature is encoded
+// in Base64 so that it can be distributed as a text file.
+func Sign(src []byte, key ed25519.PrivateKey) []byte {
+	sig := ed25519.Sign(key, src)
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(sig)))
+	base64.StdEncoding.Encode(encoded, sig)
+	return append(encoded, '\n')
+}
+
+// Verify checks the signature of a source. An empty signature means that the
+// source is unsigned. It fails if any trusted key is not an ed25519 public
+// key.
+func (t Trust) Verify(src, sig []byte) error {
+	for _, key := range t.Keys {
+		if len(key) != ed25519.PublicKeySize {
+			return fmt.Errorf("invalid public key: %d bytes", len(key))
+		}
+	}
+
+	sig = bytes.TrimSpace(sig)
+
+	if len(sig) == 0 {
+		if t.AllowUnsigned {
+			return nil
+		}
+		return ErrUnsigned
+	}
+
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(sig)))
+	n, err := base64.StdEncoding.Decode(decoded, sig)
+	if err != nil || n != ed25519.SignatureSize {
+		return fmt.Errorf("%w: malformed signature", ErrBadSignature)
+	}
+
+	for _, key := range t.Keys {
+		if ed25519.Verify(key, src, decoded[:n]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: not signed by a trusted key", ErrBadSignature)
+}
+
+// ParseSignedSpec parses a Spec from an HSL source after verifying the
+// signature.
+func ParseSignedSpec(src, sig []byte, t Trust) (*Spec, error) {
+	if err := t.Verify(src, sig); err != nil {
+		return nil, err
+	}
+	return ParseSpec(bytes.NewReader(src))
+}
+
+// LoadSignedBundle loads a bundle after verifying the signature of the whole
+// archive.
+func LoadSignedBundle(data, sig []byte, t Trust) (*Bundle, error) {
+	if err := t.Verify(data, sig); err != nil {
+		return nil, err
+	}
+	return LoadBundle(bytes.NewReader(data), int64(len(data)))
+}
+
+// OpenSignedSpec parses a Spec from a file. The signature is read from the
+// file with SignatureExt next to it.
+func OpenSignedSpec(name string, t Trust) (*Spec, error) {
+	src, sig, err := readSigned(name)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignedSpec(src, sig, t)
+}
+
+// OpenSignedBundle loads a bundle from a file. The signature is read from the
+// file with SignatureExt next to it.
+func OpenSignedBundle(name string, t Trust) (*Bundle, error) {
+	data, sig, err := readSigned(name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadSignedBundle(data, sig, t)
+}
+
+// readSigned reads a file and its detached signature. The signature is empty
+// if it doesn't exist.
+func readSigned(name string) ([]byte, []byte, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := os.ReadFile(name + SignatureExt)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+
+	return data, sig, nil
+}
@@ -0,0 +1,83 @@
+package hangulize_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedSpec(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	src := []byte(bundleHSL)
+	sig := hangulize.Sign(src, priv)
+
+	trust := hangulize.Trust{Keys: []ed25519.PublicKey{otherPub, pub}}
+	spec, err := hangulize.ParseSignedSpec(src, sig, trust)
+	require.NoError(t, err)
+	assert.Equal(t, "test", spec.Lang.ID)
+
+	// Tampered
+	tampered := bytes.Replace(src, []byte("ㅂ"), []byte("ㅍ"), 1)
+	_, err = hangulize.ParseSignedSpec(tampered, sig, trust)
+	assert.ErrorIs(t, err, hangulize.ErrBadSignature)
+
+	// Untrusted
+	untrusted := hangulize.Trust{Keys: []ed25519.PublicKey{otherPub}}
+	_, err = hangulize.ParseSignedSpec(src, sig, untrusted)
+	assert.ErrorIs(t, err, hangulize.ErrBadSignature)
+
+	// Malformed
+	_, err = hangulize.ParseSignedSpec(src, []byte("!!!"), trust)
+	assert.ErrorIs(t, err, hangulize.ErrBadSignature)
+
+	// Unsigned
+	_, err = hangulize.ParseSignedSpec(src, nil, trust)
+	assert.ErrorIs(t, err, hangulize.ErrUnsigned)
+
+	trust.AllowUnsigned = true
+	_, err = hangulize.ParseSignedSpec(src, nil, trust)
+	assert.NoError(t, err)
+
+	// A bad signature is refused even if unsigned sources are allowed.
+	_, err = hangulize.ParseSignedSpec(tampered, sig, trust)
+	assert.ErrorIs(t, err, hangulize.ErrBadSignature)
+
+	// A key with a wrong size is rejected rather than panicking.
+	invalid := hangulize.Trust{Keys: []ed25519.PublicKey{pub[:16]}}
+	_, err = hangulize.ParseSignedSpec(src, sig, invalid)
+	assert.ErrorContains(t, err, "invalid public key")
+}
+
+func TestOpenSignedBundle(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	b := &hangulize.Bundle{Spec: mustParseSpec(bundleHSL)}
+	require.NoError(t, hangulize.WriteBundle(&buf, b))
+
+	name := filepath.Join(t.TempDir(), "test"+hangulize.BundleExt)
+	require.NoError(t, os.WriteFile(name, buf.Bytes(), 0644))
+
+	trust := hangulize.Trust{Keys: []ed25519.PublicKey{pub}}
+
+	_, err = hangulize.OpenSignedBundle(name, trust)
+	assert.ErrorIs(t, err, hangulize.ErrUnsigned)
+
+	sig := hangulize.Sign(buf.Bytes(), priv)
+	require.NoError(t, os.WriteFile(name+hangulize.SignatureExt, sig, 0644))
+
+	loaded, err := hangulize.OpenSignedBundle(name, trust)
+	require.NoError(t, err)
+	assert.Equal(t, "test", loaded.Spec.Lang.ID)
+}
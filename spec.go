@@ -25,6 +25,10 @@ type Spec struct {
 	Vars      map[string][]string
 	Normalize map[string][]string
 
+	// Phrases are the results of multi-word phrases, such as "New York",
+	// which are matched before the procedure.
+	Phrases map[string]string
+
 	// Rewrite/Transcribe
 	Rewrite    []Rule
 	Transcribe []Rule
@@ -36,12 +40,17 @@ type Spec struct {
 	Source string
 
 	// Prepared stuffs
-	script script
-	puncts map[rune]bool
+	script  script
+	puncts  map[rune]bool
+	phrases []phrase
 
 	// Custom normalization
 	normReplacer *strings.Replacer
 	normLetters  map[rune]bool
+
+	// modified is true if the rules have been modified since parsed. Then
+	// the rules differ from the source.
+	modified bool
 }
 
 func (s Spec) String() string {
@@ -75,8 +84,10 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 	// lang
 	var lang Language
 
-	if sec, ok := h["lang"]; ok {
-		_lang, err := newLanguage(sec.(*hsl.DictSection))
+	if sec, err := dictSection(h, "lang"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		_lang, err := newLanguage(sec)
 
 		if err != nil {
 			return nil, err
@@ -86,10 +97,12 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 	}
 
 	// config
-	var config Config
+	config := Config{SmartAttach: true}
 
-	if sec, ok := h["config"]; ok {
-		_config, err := newConfig(sec.(*hsl.DictSection))
+	if sec, err := dictSection(h, "config"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		_config, err := newConfig(sec)
 
 		if err != nil {
 			return nil, err
@@ -101,8 +114,10 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 	// macros
 	var macros map[string]string
 
-	if sec, ok := h["macros"]; ok {
-		macros, err = sec.(*hsl.DictSection).Injective()
+	if sec, err := dictSection(h, "macros"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		macros, err = sec.Injective()
 
 		if err != nil {
 			return nil, err
@@ -111,20 +126,38 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 
 	// vars
 	var vars map[string][]string
-	if sec, ok := h["vars"]; ok {
-		vars = sec.(*hsl.DictSection).Map()
+	if sec, err := dictSection(h, "vars"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		vars = sec.Map()
 	}
 
 	// normalize
 	var normalize map[string][]string
-	if sec, ok := h["normalize"]; ok {
-		normalize = sec.(*hsl.DictSection).Map()
+	if sec, err := dictSection(h, "normalize"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		normalize = sec.Map()
+	}
+
+	// phrases
+	var phrases map[string]string
+	if sec, err := dictSection(h, "phrases"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		phrases, err = sec.Injective()
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// rewrite
 	var rewritePairs []hsl.Pair
-	if sec, ok := h["rewrite"]; ok {
-		rewritePairs = sec.(*hsl.ListSection).Pairs()
+	if sec, err := listSection(h, "rewrite"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		rewritePairs = sec.Pairs()
 	}
 
 	rewrite, err := newRules(rewritePairs, macros, vars)
@@ -134,8 +167,10 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 
 	// transcribe
 	var transcribePairs []hsl.Pair
-	if sec, ok := h["transcribe"]; ok {
-		transcribePairs = sec.(*hsl.ListSection).Pairs()
+	if sec, err := listSection(h, "transcribe"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		transcribePairs = sec.Pairs()
 	}
 
 	transcribe, err := newRules(transcribePairs, macros, vars)
@@ -145,8 +180,14 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 
 	// test
 	var test [][2]string
-	if sec, ok := h["test"]; ok {
-		for _, pair := range sec.(*hsl.ListSection).Pairs() {
+	if sec, err := listSection(h, "test"); err != nil {
+		return nil, err
+	} else if sec != nil {
+		for _, pair := range sec.Pairs() {
+			if len(pair.Right()) == 0 {
+				return nil, errors.Errorf("no expected result for %#v", pair.Left())
+			}
+
 			word := pair.Left()
 			result := pair.Right()[0]
 
@@ -193,6 +234,7 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 		macros,
 		vars,
 		normalize,
+		phrases,
 
 		rewrite,
 		transcribe,
@@ -203,13 +245,46 @@ func ParseSpec(r io.Reader) (*Spec, error) {
 
 		script,
 		puncts,
+		newPhrases(phrases),
 
 		normReplacer,
 		normLetters,
+
+		false,
 	}
 	return &spec, nil
 }
 
+// dictSection picks a dict section from an HSL. It returns nil without an
+// error if the section does not exist.
+func dictSection(h hsl.HSL, name string) (*hsl.DictSection, error) {
+	sec, ok := h[name]
+	if !ok {
+		return nil, nil
+	}
+
+	dict, ok := sec.(*hsl.DictSection)
+	if !ok {
+		return nil, errors.Errorf(`"%s" section must consist of "=" pairs`, name)
+	}
+	return dict, nil
+}
+
+// listSection picks a list section from an HSL. It returns nil without an
+// error if the section does not exist.
+func listSection(h hsl.HSL, name string) (*hsl.ListSection, error) {
+	sec, ok := h[name]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := sec.(*hsl.ListSection)
+	if !ok {
+		return nil, errors.Errorf(`"%s" section must consist of "->" pairs`, name)
+	}
+	return list, nil
+}
+
 // -----------------------------------------------------------------------------
 // "lang" section
 
@@ -221,6 +296,10 @@ type Language struct {
 	Korean   string    // The language name in Korean.
 	Script   string
 	Translit []string
+
+	// OptionalTranslit are the schemes of the Translits which run before
+	// Translit only if they are imported. A missing one is skipped silently.
+	OptionalTranslit []string
 }
 
 func (l Language) String() string {
@@ -245,6 +324,8 @@ func newLanguage(dict *hsl.DictSection) (*Language, error) {
 		Korean:   dict.One("korean"),
 		Script:   dict.One("script"),
 		Translit: dict.All("translit"),
+
+		OptionalTranslit: dict.All("optional_translit"),
 	}
 	return &lang, nil
 }
@@ -256,13 +337,28 @@ func newLanguage(dict *hsl.DictSection) (*Language, error) {
 type Config struct {
 	Authors []string
 	Stage   string
+
+	// SmartAttach merges a lead with a following syllable with the vowel
+	// carrier "ㅇ" in the result, such as "ㅂ야" -> "뱌". It is on by default
+	// and turned off by `smart_attach = "off"`.
+	SmartAttach bool
 }
 
 func newConfig(dict *hsl.DictSection) (*Config, error) {
 	config := Config{
-		Authors: dict.All("authors"),
-		Stage:   dict.One("stage"),
+		Authors:     dict.All("authors"),
+		Stage:       dict.One("stage"),
+		SmartAttach: true,
+	}
+
+	switch dict.One("smart_attach") {
+	case "", "on":
+	case "off":
+		config.SmartAttach = false
+	default:
+		return nil, errors.New(`smart_attach must be "on" or "off"`)
 	}
+
 	return &config, nil
 }
 
@@ -280,38 +376,62 @@ func newRules(
 	rules := make([]Rule, len(pairs))
 
 	for i, pair := range pairs {
-		from, err := hre.NewPattern(pair.Left(), macros, vars)
+		right := pair.Right()
+		if len(right) == 0 {
+			return nil, errors.Errorf("no replacement for %s", pair.Left())
+		}
+
+		rule, err := newRule(i, pair.Left(), right[0], macros, vars)
 		if err != nil {
 			return nil, err
 		}
+		rules[i] = rule
+	}
 
-		negAWidth, negBWidth := from.NegativeLookaroundWidths()
-		if negAWidth == -1 || negBWidth == -1 {
-			return nil, errors.Errorf(
-				"%s contains unlimited negative lookaround", from)
-		}
+	return rules, nil
+}
 
-		right := pair.Right()
-		to := hre.NewRPattern(right[0], macros, vars)
+// newRule compiles a rule from a pattern and a replacement.
+func newRule(
+	id int,
+	pattern string,
+	replacement string,
+
+	macros map[string]string,
+	vars map[string][]string,
+
+) (Rule, error) {
 
-		rules[i] = Rule{i, from, to}
+	from, err := hre.NewPattern(pattern, macros, vars)
+	if err != nil {
+		return Rule{}, err
 	}
 
-	return rules, nil
+	negAWidth, negBWidth := from.NegativeLookaroundWidths()
+	if negAWidth == -1 || negBWidth == -1 {
+		return Rule{}, errors.Errorf(
+			"%s contains unlimited negative lookaround", from)
+	}
+
+	to := hre.NewRPattern(replacement, macros, vars)
+
+	return Rule{id, from, to}, nil
 }
 
 // -----------------------------------------------------------------------------
 
 // collectPuncts collects punctuation characters from rewrite/transcribe rules.
-// It discards the punctuations that is used only for rewriting hints.
+// It discards the punctuations that is used only for rewriting hints. Digits
+// are collected as well, such as the stress numbers in ARPAbet ("AH0"), so
+// that the rules can match them.
 func collectPuncts(rewrite []Rule, transcribe []Rule) map[rune]bool {
 	puncts := make(map[rune]bool)
 	rletters := make(map[rune]bool)
 
 	collectFrom := func(rule Rule) {
 		for _, let := range rule.From.Letters() {
-			// Collect only punctuation characters. (category P)
-			if !unicode.IsPunct(let) {
+			// Collect only punctuation characters (category P) and digits.
+			if !unicode.IsPunct(let) && !unicode.IsDigit(let) {
 				continue
 			}
 
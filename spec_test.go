@@ -31,3 +31,31 @@ func TestUnlimitedNegativeLookaround(t *testing.T) {
 	`))
 	assert.Error(t, err)
 }
+
+func TestSmartAttachConfig(t *testing.T) {
+	assert.True(t, mustParseSpec(``).Config.SmartAttach)
+
+	spec := mustParseSpec(`
+	config:
+		smart_attach = "off"
+
+	transcribe:
+		"b" -> "ㅂ"
+		"ya" -> "야"
+	`)
+	assert.False(t, spec.Config.SmartAttach)
+	assert.Equal(t, "브야", mustHangulizeSpec(t, spec, "bya"))
+
+	spec = mustParseSpec(`
+	transcribe:
+		"b" -> "ㅂ"
+		"ya" -> "야"
+	`)
+	assert.Equal(t, "뱌", mustHangulizeSpec(t, spec, "bya"))
+
+	_, err := hangulize.ParseSpec(bytes.NewBufferString(`
+	config:
+		smart_attach = "maybe"
+	`))
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"embed"
 	"fmt"
 	"io/fs"
+	"log/slog"
 	"sort"
 	"strings"
 
@@ -45,6 +46,8 @@ func LoadSpec(lang string) (*Spec, error) {
 	spec, ok := specs[lang]
 	if ok {
 		// already loaded
+		logAttrs(pkgLogger.Load(), slog.LevelDebug, "spec cache hit",
+			slog.String("lang", lang))
 		return spec, nil
 	}
 
@@ -64,10 +67,14 @@ func LoadSpec(lang string) (*Spec, error) {
 
 	// Cache it.
 	specs[lang] = spec
+	logAttrs(pkgLogger.Load(), slog.LevelDebug, "spec loaded",
+		slog.String("lang", lang))
 	return spec, nil
 }
 
 // UnloadSpec flushes a cached spec to get free memory.
 func UnloadSpec(lang string) {
 	delete(specs, lang)
+	logAttrs(pkgLogger.Load(), slog.LevelDebug, "spec unloaded",
+		slog.String("lang", lang))
 }
@@ -22,6 +22,7 @@ func ExampleListLangs() {
 	// cym
 	// deu
 	// ell
+	// eng
 	// epo
 	// est
 	// fin
@@ -0,0 +1,47 @@
+package hangulize
+
+import (
+	"strings"
+)
+
+// StressMark is the marker which a Translit puts right before a stressed
+// vowel, such as "HHAHLˈOW" for "hello" in ARPAbet. It can mark an accented
+// mora for a pitch accent language as well.
+//
+// The marks are removed after the normalization unless the spec uses the
+// mark in the rules. Align reports which syllables in the result come from
+// the marked vowels.
+const StressMark = 'ˈ'
+
+// stripStress removes the stress marks in a word. It returns the offsets of
+// the marked letters in the word without the marks.
+func (p procedure) stripStress(word string) (string, []int) {
+	if !strings.ContainsRune(word, StressMark) || p.spec.puncts[StressMark] {
+		return word, nil
+	}
+
+	var buf strings.Builder
+	var stresses []int
+
+	for _, ch := range word {
+		if ch == StressMark {
+			stresses = append(stresses, buf.Len())
+			continue
+		}
+		buf.WriteRune(ch)
+	}
+
+	return buf.String(), stresses
+}
+
+// markStressed marks the segments which cover the stressed offsets.
+func markStressed(segs []Segment, stresses []int) {
+	for i, seg := range segs {
+		for _, offset := range stresses {
+			if seg.Start <= offset && offset < seg.Stop {
+				segs[i].Stressed = true
+				break
+			}
+		}
+	}
+}
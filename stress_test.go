@@ -0,0 +1,54 @@
+package hangulize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stressTranslit marks the stress on the 2nd vowel.
+type stressTranslit struct{}
+
+func (stressTranslit) Scheme() string {
+	return "stress"
+}
+
+func (stressTranslit) Transliterate(word string) (string, error) {
+	i := strings.IndexAny(word, "aeiou")
+	j := i + 1 + strings.IndexAny(word[i+1:], "aeiou")
+	return word[:j] + string(hangulize.StressMark) + word[j:], nil
+}
+
+func TestStressMark(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+		translit = "stress"
+
+	transcribe:
+		"b" -> "ㅂ"
+		"n" -> "ㄴ"
+		"a" -> "ㅏ"
+	`)
+	h := hangulize.New(spec)
+	h.UseTranslit(stressTranslit{})
+
+	// The stress marks are removed.
+	result, err := h.Hangulize("banana")
+	require.NoError(t, err)
+	assert.Equal(t, "바나나", result)
+
+	a, err := hangulize.Align(h, "banana")
+	require.NoError(t, err)
+	assert.Equal(t, "banana", a.Input)
+
+	var stressed []bool
+	for _, seg := range a.Syllables {
+		stressed = append(stressed, seg.Stressed)
+	}
+	assert.Equal(t, []bool{false, true, false}, stressed)
+}
@@ -0,0 +1,145 @@
+package hangulize
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// WriteTextGrid writes the alignment as a Praat TextGrid with 3 interval
+// tiers: "source", "phoneme" and "syllable". The time axis is the letter
+// offset in the input, so each source letter occupies a unit interval. The
+// stressed syllables are prefixed with StressMark and the long syllables are
+// suffixed with "ː".
+//
+// The segments which overlap or have been inserted without source letters
+// are merged into the preceding interval. The gaps are filled with empty
+// intervals.
+func (a *Alignment) WriteTextGrid(w io.Writer) error {
+	// The letter offset at each byte offset.
+	offsets := make([]int, len(a.Input)+1)
+	n := 0
+	for i := range a.Input {
+		offsets[i] = n
+		n++
+	}
+	offsets[len(a.Input)] = n
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			offsets[i] = offsets[i-1]
+		}
+	}
+
+	var source []Segment
+	for i, ch := range a.Input {
+		source = append(source, Segment{Text: string(ch), Start: i, Stop: i + utf8.RuneLen(ch)})
+	}
+
+	// The stressed and long syllables are marked.
+	syllables := make([]Segment, len(a.Syllables))
+	for i, seg := range a.Syllables {
+		if seg.Stressed {
+			seg.Text = string(StressMark) + seg.Text
+		}
+		if seg.Long {
+			seg.Text += "ː"
+		}
+		syllables[i] = seg
+	}
+
+	tiers := []struct {
+		name string
+		segs []Segment
+	}{
+		{"source", source},
+		{"phoneme", a.Phonemes},
+		{"syllable", syllables},
+	}
+
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "File type = \"ooTextFile\"\n")
+	fmt.Fprintf(&buf, "Object class = \"TextGrid\"\n\n")
+	fmt.Fprintf(&buf, "xmin = 0\nxmax = %d\n", n)
+	fmt.Fprintf(&buf, "tiers? <exists>\nsize = %d\nitem []:\n", len(tiers))
+
+	for i, tier := range tiers {
+		intervals := tileIntervals(tier.segs, offsets, n)
+
+		fmt.Fprintf(&buf, "    item [%d]:\n", i+1)
+		fmt.Fprintf(&buf, "        class = \"IntervalTier\"\n")
+		fmt.Fprintf(&buf, "        name = %s\n", quoteTextGrid(tier.name))
+		fmt.Fprintf(&buf, "        xmin = 0\n        xmax = %d\n", n)
+		fmt.Fprintf(&buf, "        intervals: size = %d\n", len(intervals))
+
+		for j, iv := range intervals {
+			fmt.Fprintf(&buf, "        intervals [%d]:\n", j+1)
+			fmt.Fprintf(&buf, "            xmin = %d\n", iv.Start)
+			fmt.Fprintf(&buf, "            xmax = %d\n", iv.Stop)
+			fmt.Fprintf(&buf, "            text = %s\n", quoteTextGrid(iv.Text))
+		}
+	}
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// tileIntervals converts segments in byte offsets into intervals in letter
+// offsets which tile [0, total] without overlaps.
+func tileIntervals(segs []Segment, offsets []int, total int) []Segment {
+	sorted := make([]Segment, len(segs))
+	for i, seg := range segs {
+		sorted[i] = Segment{Text: seg.Text, Start: offsets[seg.Start], Stop: offsets[seg.Stop]}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	var intervals []Segment
+	pos := 0
+	pending := ""
+
+	for _, seg := range sorted {
+		if seg.Start > pos {
+			intervals = append(intervals, Segment{Text: "", Start: pos, Stop: seg.Start})
+			pos = seg.Start
+		}
+
+		last := len(intervals) - 1
+
+		switch {
+
+		case seg.Start == seg.Stop && (last < 0 || intervals[last].Text == ""):
+			// An inserted segment after a gap goes to the next interval.
+			pending += seg.Text
+
+		case seg.Start < pos || seg.Start == seg.Stop:
+			// Merge an overlapping or inserted segment into the preceding
+			// interval.
+			intervals[last].Text += seg.Text
+			if seg.Stop > pos {
+				intervals[last].Stop = seg.Stop
+				pos = seg.Stop
+			}
+
+		default:
+			intervals = append(intervals, Segment{Text: pending + seg.Text, Start: seg.Start, Stop: seg.Stop})
+			pending = ""
+			pos = seg.Stop
+
+		}
+	}
+
+	if pos < total {
+		intervals = append(intervals, Segment{Text: pending, Start: pos, Stop: total})
+	}
+	return intervals
+}
+
+// quoteTextGrid quotes a string in the TextGrid format which escapes a double
+// quote by doubling it.
+func quoteTextGrid(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
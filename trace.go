@@ -3,7 +3,7 @@ package hangulize
 import (
 	"strings"
 
-	"github.com/hangulize/hangulize/internal/subword"
+	"github.com/hangulize/hangulize/pkg/subword"
 )
 
 // Trace is a tracing event which the Hangulize procedure emits.
@@ -89,6 +89,12 @@ func (r *tracer) Syllabify(word string) {
 	r.trace(Trace{Step: "Syllabify", Word: word})
 }
 
+// Overlay traces an "Overlay" step which rewrites the result of the
+// "transcribe" step by an option.
+func (r *tracer) Overlay(word, why string) {
+	r.trace(Trace{Step: "Overlay", Word: word, Why: why})
+}
+
 // Localize traces a "Localize" step.
 func (r *tracer) Localize(word, script string) {
 	r.trace(Trace{Step: "Localize", Word: word, Why: script})
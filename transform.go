@@ -0,0 +1,72 @@
+package hangulize
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// NewTransformer creates a transform.Transformer which hangulizes a text by a
+// Hangulizer. It composes with the other transformers in golang.org/x/text:
+//
+//	t := transform.Chain(norm.NFC, hangulize.NewTransformer(h))
+//	r := transform.NewReader(file, t)
+//
+// The text is hangulized in chunks which end with a whitespace. So a word
+// longer than the buffer of the transform.Reader fails with
+// transform.ErrShortSrc.
+func NewTransformer(h Hangulizer) transform.Transformer {
+	return &transformer{h: h}
+}
+
+type transformer struct {
+	transform.NopResetter
+	h Hangulizer
+}
+
+func (t *transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	// Hangulize until the last whitespace not to break a word.
+	n := len(src)
+	if !atEOF {
+		n = lastSpace(src)
+		if n == 0 {
+			return 0, 0, transform.ErrShortSrc
+		}
+	}
+
+	// The rest after n is left for the next call.
+	err = nil
+	if n < len(src) {
+		err = transform.ErrShortSrc
+	}
+
+	for {
+		result, hErr := t.h.Hangulize(string(src[:n]))
+		if hErr != nil {
+			return 0, 0, hErr
+		}
+
+		if len(result) <= len(dst) {
+			return copy(dst, result), n, err
+		}
+
+		// Try a shorter chunk to fit in dst.
+		err = transform.ErrShortDst
+		if n = lastSpace(src[:n-1]); n == 0 {
+			return 0, 0, err
+		}
+	}
+}
+
+// lastSpace finds the end of the last whitespace in the text. It returns 0 if
+// there is no whitespace.
+func lastSpace(text []byte) int {
+	i := bytes.LastIndexFunc(text, unicode.IsSpace)
+	if i == -1 {
+		return 0
+	}
+	_, size := utf8.DecodeRune(text[i:])
+	return i + size
+}
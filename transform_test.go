@@ -0,0 +1,43 @@
+package hangulize_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/hangulize/hangulize"
+)
+
+func TestTransformer(t *testing.T) {
+	spec, _ := hangulize.LoadSpec("ita")
+	h := hangulize.New(spec)
+
+	result, _, err := transform.String(hangulize.NewTransformer(h), "Cappuccino gelato")
+	require.NoError(t, err)
+	assert.Equal(t, "카푸치노 젤라토", result)
+
+	// Composable with the other transformers.
+	chain := transform.Chain(norm.NFD, norm.NFC, hangulize.NewTransformer(h))
+	result, _, err = transform.String(chain, "Cappuccino\n")
+	require.NoError(t, err)
+	assert.Equal(t, "카푸치노\n", result)
+}
+
+func TestTransformerReader(t *testing.T) {
+	spec, _ := hangulize.LoadSpec("ita")
+	h := hangulize.New(spec)
+
+	// Longer than the buffer of transform.Reader.
+	text := strings.Repeat("Cappuccino gelato\n", 1000)
+	expected := strings.Repeat("카푸치노 젤라토\n", 1000)
+
+	r := transform.NewReader(strings.NewReader(text), hangulize.NewTransformer(h))
+	result, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(result))
+}
@@ -11,6 +11,43 @@ type Translit interface {
 	Transliterate(string) (string, error)
 }
 
+// Dataset is a versioned data which a Translit relies on, such as a
+// pronunciation dictionary.
+type Dataset struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// DatasetTranslit is an optional interface for a Translit which relies on
+// datasets. The datasets are reported by ProvenanceOf.
+type DatasetTranslit interface {
+	Translit
+
+	// Datasets returns the datasets which the Translit relies on.
+	Datasets() []Dataset
+}
+
+// TranslitSpan is the correspondence between a chunk of the input of a
+// Translit and the chunk of the output which it has produced.
+type TranslitSpan struct {
+	// Start and Stop are the byte offsets in the input.
+	Start, Stop int
+
+	// OutStart and OutStop are the byte offsets in the output.
+	OutStart, OutStop int
+}
+
+// SpanTranslit is an optional interface for a Translit which reports the
+// spans of its output. Align uses it to align the result with the words in
+// the original input.
+type SpanTranslit interface {
+	Translit
+
+	// TransliterateSpans transliterates the given word like Transliterate
+	// and reports the spans of the output in order.
+	TransliterateSpans(string) (string, []TranslitSpan, error)
+}
+
 // translitRegistry is a registry holding Translits.
 type translitRegistry map[string]Translit
 
@@ -0,0 +1,35 @@
+// Package all imports all of the standard Translits into the default registry
+// by a blank import:
+//
+//	import _ "github.com/hangulize/hangulize/translit/all"
+//
+// Then specs which require a Translit, such as "jpn" or "chi", work with
+// hangulize.Hangulize without any other setup.
+package all
+
+import (
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+)
+
+func init() {
+	UseAll()
+}
+
+// UseAll imports all of the standard Translits. Unlike translit.Install, it
+// keeps the Translits already imported for the same schemes instead of
+// failing.
+func UseAll(h ...hangulize.Hangulizer) {
+	if len(h) > 1 {
+		panic("usage: all.UseAll([hangulizer])")
+	}
+
+	useTranslit := hangulize.UseTranslit
+	if len(h) == 1 {
+		useTranslit = h[0].UseTranslit
+	}
+
+	for _, t := range translit.Translits() {
+		useTranslit(t)
+	}
+}
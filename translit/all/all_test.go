@@ -0,0 +1,30 @@
+package all_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit"
+	"github.com/hangulize/hangulize/translit/all"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlankImport(t *testing.T) {
+	translits := hangulize.Translits()
+	for _, tr := range translit.Translits() {
+		assert.Contains(t, translits, tr.Scheme())
+	}
+
+	// Imported twice
+	all.UseAll()
+	assert.Len(t, hangulize.Translits(), len(translits))
+}
+
+func TestUseAllInstance(t *testing.T) {
+	h := hangulize.New(&hangulize.Spec{})
+	all.UseAll(h)
+
+	translits := h.Translits()
+	assert.Contains(t, translits, "furigana")
+	assert.Contains(t, translits, "pinyin")
+}
@@ -0,0 +1,93 @@
+package english
+
+import "strings"
+
+// letterPhonemes are the pronunciations of the names of the Latin letters in
+// ARPAbet.
+var letterPhonemes = map[rune]string{
+	'A': "EY1", 'B': "B IY1", 'C': "S IY1", 'D': "D IY1", 'E': "IY1",
+	'F': "EH1 F", 'G': "JH IY1", 'H': "EY1 CH", 'I': "AY1", 'J': "JH EY1",
+	'K': "K EY1", 'L': "EH1 L", 'M': "EH1 M", 'N': "EH1 N", 'O': "OW1",
+	'P': "P IY1", 'Q': "K Y UW1", 'R': "AA1 R", 'S': "EH1 S", 'T': "T IY1",
+	'U': "Y UW1", 'V': "V IY1", 'W': "D AH1 B AH0 L Y UW0", 'X': "EH1 K S",
+	'Y': "W AY1", 'Z': "Z IY1",
+}
+
+// letterOnsets are the consonant letters which may begin a pronounceable
+// acronym such as "SCUBA". Single consonants are legal too.
+var letterOnsets = map[string]bool{
+	"BL": true, "BR": true, "CH": true, "CL": true, "CR": true, "DR": true,
+	"FL": true, "FR": true, "GL": true, "GR": true, "KL": true, "KN": true,
+	"KR": true, "PH": true, "PL": true, "PR": true, "SC": true, "SH": true,
+	"SK": true, "SL": true, "SM": true, "SN": true, "SP": true, "ST": true,
+	"SW": true, "TH": true, "TR": true, "TW": true, "WH": true, "WR": true,
+
+	"SCR": true, "SPL": true, "SPR": true, "STR": true, "THR": true,
+}
+
+// spellInitialism pronounces an initialism in uppercase letter by letter,
+// such as "FBI" -> "EH1 F B IY1 AY1". The letters may be separated by dots
+// as in "F.B.I". It returns false if the word is not an initialism. An
+// acronym pronounceable as a word, such as "NASA", is not an initialism.
+func spellInitialism(word string) (string, bool) {
+	letters := word
+	if strings.Contains(word, ".") {
+		letters = strings.ReplaceAll(word, ".", "")
+		if len(word) != 2*len(letters)-1 {
+			return "", false
+		}
+	}
+
+	if len(letters) < 2 {
+		return "", false
+	}
+	prons := make([]string, 0, len(letters))
+	for _, r := range letters {
+		pron, ok := letterPhonemes[r]
+		if !ok {
+			return "", false
+		}
+		prons = append(prons, pron)
+	}
+
+	if letters == word && isPronounceableAcronym(word) {
+		return "", false
+	}
+	return strings.Join(prons, " "), true
+}
+
+// isPronounceableAcronym reports whether an acronym in uppercase seems to be
+// read as a word. It should have vowels and the consonants between them
+// should be able to form English syllables.
+func isPronounceableAcronym(word string) bool {
+	if len(word) < 3 {
+		return false
+	}
+
+	// Split the word into runs of consonants by the vowels.
+	runs := strings.FieldsFunc(word, func(r rune) bool {
+		return strings.ContainsRune("AEIOUY", r)
+	})
+	if strings.Join(runs, "") == word {
+		// No vowels.
+		return false
+	}
+
+	for i, run := range runs {
+		switch {
+		case len(run) <= 1:
+		case i == 0 && strings.HasPrefix(word, run):
+			// The leading consonants should be a legal onset.
+			if !letterOnsets[run] {
+				return false
+			}
+		case i == len(runs)-1 && strings.HasSuffix(word, run):
+			// The trailing consonants hardly form a coda in acronyms
+			// such as "IBM".
+			return false
+		case len(run) > 3:
+			return false
+		}
+	}
+	return true
+}
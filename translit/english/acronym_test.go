@@ -0,0 +1,29 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestInitialism(t *testing.T) {
+	result, err := english.T.Transliterate("GPU QA F.B.I.")
+	require.NoError(t, err)
+	assert.Equal(t, "JHIYPIYYUW KYUWEY EHFBIYAY.", result)
+
+	tokens := english.Analyze("XKCD ZOLUBA Gpu")
+
+	// Spelled letter by letter.
+	assert.True(t, tokens[0].Initialism)
+	assert.Equal(t, "EHKSKEYSIYDIY", tokens[0].Phonemes)
+
+	// Pronounceable as a word.
+	assert.False(t, tokens[1].Initialism)
+	assert.False(t, tokens[1].Known)
+
+	// Not in uppercase.
+	assert.False(t, tokens[2].Initialism)
+}
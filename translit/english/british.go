@@ -0,0 +1,69 @@
+package english
+
+import (
+	"strings"
+
+	"github.com/hangulize/hangulize"
+)
+
+// UK is a hangulize.Translit for British English in Received Pronunciation.
+// Its scheme is "english-uk".
+var UK hangulize.Translit = New(British(true))
+
+// Ts are the English Translits in General American and Received
+// Pronunciation.
+var Ts = []hangulize.Translit{T, UK}
+
+// British chooses whether to pronounce words in Received Pronunciation
+// rather than General American. The scheme becomes "english-uk".
+//
+// The words which differ irregularly, such as "tomato", are found in a small
+// British dictionary. The other pronunciations in CMUdict are transformed
+// systematically:
+//
+//   - "R" not followed by a vowel is dropped: "car" -> "K AA1"
+//   - A centering diphthong replaces "R" after a front or high vowel:
+//     "near" -> "N IH1 AH0"
+//   - Unstressed "ER" is reduced to "AH": "water" -> "W AO1 T AH0"
+//   - "AA" in a word spelled with "o" but not "a" is the LOT vowel, which
+//     ARPAbet writes as "AO": "hot" -> "HH AO1 T"
+func British(enabled bool) Option {
+	return func(p *english) { p.british = enabled }
+}
+
+// centeringVowels are the vowels which form centering diphthongs with the
+// following "R" in Received Pronunciation, such as /ɪə/, /eə/, or /ʊə/.
+var centeringVowels = map[string]bool{
+	"IH": true, "IY": true, "EH": true, "EY": true, "UH": true, "UW": true,
+	"AY": true,
+}
+
+// toBritish transforms a pronunciation in General American from CMUdict to
+// Received Pronunciation. key is the lowercase spelling of the word.
+func toBritish(key, pron string) string {
+	phonemes := strings.Fields(pron)
+	lot := strings.Contains(key, "o") && !strings.Contains(key, "a")
+
+	out := make([]string, 0, len(phonemes))
+	for i, ph := range phonemes {
+		bare := strings.TrimRight(ph, "012")
+		stress := ph[len(bare):]
+
+		switch {
+		case bare == "R":
+			if i+1 < len(phonemes) && vowels[strings.TrimRight(phonemes[i+1], "012")] {
+				// Prevocalic "R" remains.
+				out = append(out, ph)
+			} else if i > 0 && centeringVowels[strings.TrimRight(phonemes[i-1], "012")] {
+				out = append(out, "AH0")
+			}
+		case bare == "ER" && stress == "0":
+			out = append(out, "AH0")
+		case bare == "AA" && lot:
+			out = append(out, "AO"+stress)
+		default:
+			out = append(out, ph)
+		}
+	}
+	return strings.Join(out, " ")
+}
@@ -0,0 +1,45 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestBritish(t *testing.T) {
+	assert.Equal(t, "english", english.T.Scheme())
+	assert.Equal(t, "english-uk", english.UK.Scheme())
+
+	for word, want := range map[string]string{
+		// The British dictionary
+		"tomato": "TAHMAATOW",
+		"bath":   "BAATH",
+
+		// Non-rhotic
+		"car":   "KAA",
+		"card":  "KAAD",
+		"carry": "KAERIY",
+		"near":  "NIHAH",
+		"water": "WAOTAH",
+
+		// The LOT vowel
+		"hot":    "HHAOT",
+		"father": "FAADHAH",
+	} {
+		result, err := english.UK.Transliterate(word)
+		require.NoError(t, err)
+		assert.Equal(t, want, result, word)
+	}
+
+	// General American
+	result, err := english.T.Transliterate("tomato car hot")
+	require.NoError(t, err)
+	assert.Equal(t, "TAHMEYTOW KAAR HHAAT", result)
+
+	result, err = english.New(english.British(true), english.IPA(true)).Transliterate("bird goat")
+	require.NoError(t, err)
+	assert.Equal(t, "bɜd ɡəʊt", result)
+}
@@ -0,0 +1,97 @@
+package english
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheSize chooses the number of words whose pronunciations are memoized
+// by the Translit. It saves repeated lookups when a corpus has the same
+// words repeatedly. The least recently used word is evicted when the cache
+// is full. The cache is disabled by default or if size is 0.
+//
+// The cache is flushed when the dictionaries are changed by Reload or
+// AddPronunciation. It is not used with Disambiguate because the
+// pronunciations depend on the context.
+func CacheSize(size int) Option {
+	return func(p *english) {
+		if size <= 0 {
+			p.cache = nil
+			return
+		}
+		p.cache = newLRUCache(size)
+	}
+}
+
+// lruCache is a bounded cache of the pronunciations of words. It is safe to
+// use it concurrently.
+type lruCache struct {
+	mu   sync.Mutex
+	size int
+
+	// snapshot is the dictionaries which the cached pronunciations are
+	// looked up in.
+	snapshot *snapshot
+
+	// order keeps the entries from the most recently used one.
+	order *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key string
+	tok Token
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// reset flushes the cache if the snapshot of the dictionaries has been
+// changed.
+func (c *lruCache) reset(s *snapshot) {
+	if c.snapshot == s {
+		return
+	}
+	c.snapshot = s
+	c.order.Init()
+	clear(c.items)
+}
+
+// get finds the cached token of a word looked up in the snapshot.
+func (c *lruCache) get(s *snapshot, key string) (Token, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset(s)
+
+	e, ok := c.items[key]
+	if !ok {
+		return Token{}, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).tok, true
+}
+
+// put caches the token of a word looked up in the snapshot.
+func (c *lruCache) put(s *snapshot, key string, tok Token) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset(s)
+
+	if e, ok := c.items[key]; ok {
+		e.Value.(*cacheEntry).tok = tok
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&cacheEntry{key, tok})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,73 @@
+package english
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	s := &snapshot{}
+	c := newLRUCache(2)
+
+	c.put(s, "a", Token{Phonemes: "EY"})
+	c.put(s, "b", Token{Phonemes: "BIY"})
+
+	// "a" becomes the most recently used one.
+	_, ok := c.get(s, "a")
+	assert.True(t, ok)
+
+	// "b" is evicted.
+	c.put(s, "c", Token{Phonemes: "SIY"})
+	_, ok = c.get(s, "b")
+	assert.False(t, ok)
+
+	tok, ok := c.get(s, "a")
+	assert.True(t, ok)
+	assert.Equal(t, "EY", tok.Phonemes)
+
+	// Flushed by another snapshot.
+	_, ok = c.get(&snapshot{}, "a")
+	assert.False(t, ok)
+}
+
+func TestCacheSize(t *testing.T) {
+	embedded := loadSnapshot()
+	defer current.Store(embedded)
+
+	p := New(CacheSize(16))
+
+	result, err := p.Transliterate("Hello hello, hello.")
+	require.NoError(t, err)
+	assert.Equal(t, "HHAHLOW HHAHLOW, HHAHLOW.", result)
+	// "Hello" and "hello" are cached separately because an initialism or a
+	// proper noun depends on the letter case.
+	assert.Equal(t, 2, p.(*english).cache.order.Len())
+
+	// Flushed when the dictionaries are changed.
+	require.NoError(t, Reload(strings.NewReader(reloadedDict)))
+	result, err = p.Transliterate("hello")
+	require.NoError(t, err)
+	assert.Equal(t, "HHEHLOW", result)
+
+	assert.Nil(t, New(CacheSize(0)).(*english).cache)
+}
+
+func BenchmarkTransliterate(b *testing.B) {
+	text := strings.Repeat("the quick brown fox jumps over the lazy dog ", 100)
+
+	b.Run("NoCache", func(b *testing.B) {
+		p := New()
+		for i := 0; i < b.N; i++ {
+			_, _ = p.Transliterate(text)
+		}
+	})
+	b.Run("Cache", func(b *testing.B) {
+		p := New(CacheSize(1024))
+		for i := 0; i < b.N; i++ {
+			_, _ = p.Transliterate(text)
+		}
+	})
+}
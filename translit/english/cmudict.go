@@ -0,0 +1,21 @@
+//go:build !nodict
+
+package english
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed" // Required for go:embed
+	"io"
+)
+
+// cmudictGzip is CMUdict compressed by "gzip -9 -n" to shrink the binaries.
+// It is decompressed on the first use.
+//
+//go:embed cmudict.dict.gz
+var cmudictGzip []byte
+
+// openCMUdict opens the embedded CMUdict decompressing it.
+func openCMUdict() (io.Reader, error) {
+	return gzip.NewReader(bytes.NewReader(cmudictGzip))
+}
@@ -0,0 +1,10 @@
+//go:build nodict
+
+package english
+
+import "io"
+
+// openCMUdict fails because CMUdict is not embedded with the "nodict" tag.
+func openCMUdict() (io.Reader, error) {
+	return nil, ErrNoDict
+}
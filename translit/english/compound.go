@@ -0,0 +1,97 @@
+package english
+
+import "strings"
+
+// contractions are the pronunciations of the clitics in contractions such as
+// "hasn't" or "it'll". The first one follows a vowel and the second one
+// follows a consonant.
+var contractions = []struct {
+	suffix string
+	vowel  string
+	cons   string
+}{
+	{"n't", "N T", "AH0 N T"},
+	{"'ll", "L", "AH0 L"},
+	{"'re", "R", "ER0"},
+	{"'ve", "V", "AH0 V"},
+	{"'d", "D", "D"},
+	{"'m", "M", "M"},
+}
+
+// lookupCompound finds the pronunciation of a word which is not in the
+// dictionaries by its parts. A hyphenated compound is pronounced part by
+// part, and a possessive or contraction is pronounced as the base word with
+// the clitic: "well-known", "Zorbo's", "they'd've".
+func (p *english) lookupCompound(s *snapshot, key string, proper bool, words []string, i int) (string, bool) {
+	if strings.Contains(key, "-") {
+		parts := strings.FieldsFunc(key, func(r rune) bool { return r == '-' })
+		if len(parts) == 0 {
+			return "", false
+		}
+
+		prons := make([]string, len(parts))
+		for j, part := range parts {
+			pron, ok := p.lookup(s, part, proper, words, i)
+			if !ok {
+				return "", false
+			}
+			prons[j] = pron
+		}
+		return strings.Join(prons, " "), true
+	}
+
+	if base, ok := strings.CutSuffix(key, "'s"); ok && base != "" {
+		pron, ok := p.lookup(s, base, proper, words, i)
+		if !ok {
+			return "", false
+		}
+		return pron + " " + possessive(pron), true
+	}
+
+	for _, c := range contractions {
+		base, ok := strings.CutSuffix(key, c.suffix)
+		if !ok || base == "" {
+			continue
+		}
+
+		pron, ok := p.lookup(s, base, proper, words, i)
+		if !ok {
+			return "", false
+		}
+
+		last := lastPhoneme(pron)
+		switch {
+		case c.suffix == "'d" && (last == "T" || last == "D"):
+			return pron + " IH0 D", true
+		case vowels[last]:
+			return pron + " " + c.vowel, true
+		default:
+			return pron + " " + c.cons, true
+		}
+	}
+
+	return "", false
+}
+
+// possessive chooses the pronunciation of the possessive 's by the last
+// phoneme of the word: /ɪz/ after a sibilant, /s/ after a voiceless
+// consonant, and /z/ otherwise.
+func possessive(pron string) string {
+	switch lastPhoneme(pron) {
+	case "S", "Z", "SH", "ZH", "CH", "JH":
+		return "IH0 Z"
+	case "P", "T", "K", "F", "TH":
+		return "S"
+	}
+	return "Z"
+}
+
+// lastPhoneme returns the last phoneme in a pronunciation without the stress
+// number.
+func lastPhoneme(pron string) string {
+	fields := strings.Fields(pron)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.TrimRight(fields[len(fields)-1], "012")
+}
@@ -0,0 +1,63 @@
+package english
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// WithDictReader replaces the embedded CMUdict with a dictionary in the same
+// format read from r, such as an updated or trimmed one. The dictionary is
+// read when the Translit is created. If it fails, Transliterate returns the
+// error.
+func WithDictReader(r io.Reader) Option {
+	return func(p *english) {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			p.dictErr = fmt.Errorf("english: reading dictionary: %w", err)
+			return
+		}
+
+		p.dict = newDictionary(string(data))
+		p.dictVersion = cmudictVersion(strings.NewReader(string(data)))
+	}
+}
+
+// WithDictFile replaces the embedded CMUdict with a dictionary file like
+// WithDictReader.
+func WithDictFile(name string) Option {
+	return func(p *english) {
+		file, err := os.Open(name)
+		if err != nil {
+			p.dictErr = fmt.Errorf("english: %w", err)
+			return
+		}
+		defer file.Close()
+		WithDictReader(file)(p)
+	}
+}
+
+// lookupDict finds the pronunciation of a lowercase word in the dictionary
+// of the Translit or CMUdict in the snapshot. words[i] is the word in the
+// input. The variants are disambiguated by the context or chosen by the
+// Chooser.
+func (p *english) lookupDict(s *snapshot, key string, words []string, i int) (string, bool) {
+	d := s.dict
+	if p.dict != nil {
+		d = p.dict
+	}
+
+	prons := variants(d, key)
+	switch {
+	case len(prons) == 0:
+		return "", false
+	case len(prons) == 1:
+		return prons[0], true
+	case p.disambiguate != nil:
+		return p.disambiguate(Heteronym{words, i, prons}), true
+	case p.chooser != nil:
+		return p.chooser(key, prons), true
+	}
+	return prons[0], true
+}
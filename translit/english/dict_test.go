@@ -0,0 +1,44 @@
+package english_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+const trimmedDict = `;;; # CMUdict  --  Major Version: 0.07
+;;; $Id:: cmudict-0.7c-trimmed 2024-01-01 $
+HELLO  HH EH0 L OW1
+WORLD  W ER1 L D
+`
+
+func TestWithDictReader(t *testing.T) {
+	tr := english.New(english.WithDictReader(strings.NewReader(trimmedDict)))
+
+	result, err := tr.Transliterate("hello world banana")
+	require.NoError(t, err)
+	assert.Equal(t, "HHEHLOW WERLD banana", result)
+
+	datasets := tr.(hangulize.DatasetTranslit).Datasets()
+	assert.Equal(t, "0.7c-trimmed", datasets[0].Version)
+}
+
+func TestWithDictFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "cmudict.dict")
+	require.NoError(t, os.WriteFile(name, []byte(trimmedDict), 0644))
+
+	result, err := english.New(english.WithDictFile(name)).Transliterate("world")
+	require.NoError(t, err)
+	assert.Equal(t, "WERLD", result)
+
+	// A missing file fails on Transliterate.
+	_, err = english.New(english.WithDictFile(name + ".missing")).Transliterate("world")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
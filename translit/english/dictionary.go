@@ -0,0 +1,95 @@
+package english
+
+import (
+	"io"
+	"slices"
+	"strings"
+)
+
+// dictionary is a packed index over a pronunciation dictionary in the
+// CMUdict format. It keeps the text as is and the offsets of the entries
+// sorted by the words, so a lookup is a binary search without materializing
+// every entry in a map.
+type dictionary struct {
+	text    string
+	entries []uint32
+}
+
+// readDictionary reads a pronunciation dictionary into a packed index.
+func readDictionary(r io.Reader) (*dictionary, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return newDictionary(string(data)), nil
+}
+
+// newDictionary indexes a pronunciation dictionary.
+func newDictionary(text string) *dictionary {
+	d := &dictionary{text: text}
+
+	for offset := 0; offset < len(text); {
+		end := strings.IndexByte(text[offset:], '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += offset
+		}
+
+		line := text[offset:end]
+		if !strings.HasPrefix(line, ";;;") && strings.Contains(line, "  ") {
+			d.entries = append(d.entries, uint32(offset))
+		}
+		offset = end + 1
+	}
+
+	// CMUdict is almost sorted, but not in the case-insensitive order.
+	slices.SortStableFunc(d.entries, func(a, b uint32) int {
+		return compareFold(d.word(a), d.word(b))
+	})
+	return d
+}
+
+// word is the word of the entry at an offset.
+func (d *dictionary) word(offset uint32) string {
+	line := d.text[offset:]
+	return line[:strings.Index(line, "  ")]
+}
+
+// lookup finds the pronunciation of a lowercase word.
+func (d *dictionary) lookup(key string) (string, bool) {
+	i, ok := slices.BinarySearchFunc(d.entries, key, func(offset uint32, key string) int {
+		return compareFold(d.word(offset), key)
+	})
+	if !ok {
+		return "", false
+	}
+
+	line := d.text[d.entries[i]:]
+	if end := strings.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+	_, pron, _ := strings.Cut(line, "  ")
+	return strings.TrimRight(pron, "\r"), true
+}
+
+// compareFold compares 2 words ignoring the case of ASCII letters.
+func compareFold(a, b string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, y := lowerASCII(a[i]), lowerASCII(b[i])
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
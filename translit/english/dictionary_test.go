@@ -0,0 +1,42 @@
+package english
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionary(t *testing.T) {
+	d := newDictionary(`;;; comments
+ZEBRA  Z IY1 B R AH0
+ABC  EY2 B IY2 S IY1
+A  AH0
+A(1)  EY1
+ABC'S  EY2 B IY2 S IY1 Z
+`)
+
+	for key, expected := range map[string]string{
+		"a":     "AH0",
+		"a(1)":  "EY1",
+		"abc":   "EY2 B IY2 S IY1",
+		"abc's": "EY2 B IY2 S IY1 Z",
+		"zebra": "Z IY1 B R AH0",
+	} {
+		pron, ok := d.lookup(key)
+		assert.True(t, ok, key)
+		assert.Equal(t, expected, pron, key)
+	}
+
+	for _, key := range []string{"", "ab", "zebras", ";;; comments"} {
+		_, ok := d.lookup(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func BenchmarkLoadDictionaries(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r, _ := openCMUdict()
+		_, _ = readDictionary(r)
+	}
+}
@@ -2,38 +2,118 @@
 Package english implements a transliterator for English.
 It uses a dictionary-based approach to look up the phonetic
 pronunciation of a word (in ARPAbet) before transcription.
+
+CMUdict is embedded by default. Build with the "nodict" tag to exclude it
+from size-constrained binaries such as WASM. Then a dictionary should be
+provided at runtime by Reload, WithDictReader, or WithDictFile. Otherwise,
+Transliterate fails with ErrNoDict.
 */
 package english
 
 import (
 	"bufio"
 	_ "embed" // Required for go:embed
+	"errors"
 	"io"
 	"strings"
-	"sync"
 
 	"github.com/hangulize/hangulize"
 )
 
-//go:embed cmudict.dict
-var cmudict string
+//go:embed names.dict
+var namesDict string
+
+//go:embed propernouns.dict
+var properNounsDict string
+
+//go:embed british.dict
+var britishDict string
+
+//go:embed phrases.dict
+var phrasesDict string
+
+// ErrNoDict occurs when the package is built with the "nodict" tag but no
+// dictionary is provided.
+var ErrNoDict = errors.New("english: no dictionary; built with nodict")
 
 // T is a hangulize.Translit for English.
-var T hangulize.Translit = &english{}
+var T hangulize.Translit = New()
 
 //------------------------------------------------------------------------------
 
-var (
-	dict map[string]string
-	once sync.Once
-)
+type english struct {
+	syllabify     bool
+	letterNames   bool
+	letterToSound bool
+	markStress    bool
+	keepStress    bool
+	ipa           bool
+	reportOOV     bool
+	properNouns   bool
+	british       bool
+	cache         *lruCache
+	chooser       Chooser
+	disambiguate  func(Heteronym) string
 
-type english struct{}
+	// dict replaces the embedded CMUdict if it is not nil. dictErr is the
+	// error while loading it.
+	dict        *dictionary
+	dictVersion string
+	dictErr     error
+}
+
+// Option customizes a Translit made by New.
+type Option func(*english)
+
+// New creates a Translit for English with options.
+func New(opts ...Option) hangulize.Translit {
+	p := &english{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
 
-func (english) Scheme() string {
+func (p *english) Scheme() string {
+	if p.british {
+		return "english-uk"
+	}
 	return "english"
 }
 
+// Datasets reports the version of the CMUdict in use.
+func (p *english) Datasets() []hangulize.Dataset {
+	version := "unknown"
+	if s := current.Load(); s != nil {
+		version = s.dictVersion
+	} else if r, err := openCMUdict(); err == nil {
+		// Read only the header without loading the whole dictionary.
+		version = cmudictVersion(r)
+	}
+	if p.dict != nil {
+		version = p.dictVersion
+	}
+	return []hangulize.Dataset{{Name: "CMUdict", Version: version}}
+}
+
+// cmudictVersion finds the version in the header comments of CMUdict, such
+// as "0.7b" from "$Id:: cmudict-0.7b ...".
+func cmudictVersion(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, ";;;") {
+			break
+		}
+		for _, field := range strings.Fields(line) {
+			if strings.HasPrefix(field, "cmudict-") {
+				return strings.TrimPrefix(field, "cmudict-")
+			}
+		}
+	}
+	return "unknown"
+}
+
 // loadDictionary parses a pronunciation dictionary.
 func loadDictionary(r io.Reader) (map[string]string, error) {
 	dict := make(map[string]string)
@@ -47,37 +127,109 @@ func loadDictionary(r io.Reader) (map[string]string, error) {
 		parts := strings.SplitN(line, "  ", 2)
 		if len(parts) == 2 {
 			word := strings.ToLower(parts[0])
-			pron := parts[1]
-			// Remove stress numbers from phonemes for simplicity (e.g., "AH0" -> "AH").
-			pron = strings.NewReplacer("0", "", "1", "", "2", "", " ", "").Replace(pron)
-			dict[word] = pron
+			dict[word] = parts[1]
 		}
 	}
 	return dict, scanner.Err()
 }
 
+// format writes phonemes in ARPAbet or IPA. Stress numbers are removed for
+// simplicity (e.g., "AH0" -> "AH") unless KeepStress is enabled.
+func (p *english) format(phonemes []string) string {
+	bare := make([]string, len(phonemes))
+	stressed := make([]bool, len(phonemes))
+	for i, ph := range phonemes {
+		stressed[i] = p.markStress && strings.HasSuffix(ph, "1")
+		bare[i] = strings.TrimRight(ph, "012")
+	}
+
+	out := bare
+	switch {
+	case p.ipa:
+		out = make([]string, len(phonemes))
+		for i, ph := range phonemes {
+			out[i] = toIPA(ph, p.british)
+		}
+	case p.keepStress:
+		out = phonemes
+	}
+
+	if !p.syllabify {
+		return joinStressed(out, stressed)
+	}
+
+	// The syllables are found by the phonemes without stress numbers.
+	syllables := syllabify(bare)
+	chunks := make([]string, len(syllables))
+	for i, syl := range syllables {
+		chunks[i] = joinStressed(out[:len(syl)], stressed[:len(syl)])
+		out, stressed = out[len(syl):], stressed[len(syl):]
+	}
+
+	// U+200B: Zero Width Space
+	return strings.Join(chunks, "\u200b")
+}
+
 // Transliterate converts an English word to its phonetic representation.
+// The punctuation marks and whitespace are kept as they are in the input:
+// "Hello, world!" -> "HHAHLOW, WERLD!".
 func (p *english) Transliterate(word string) (string, error) {
-	// Lazily load the embedded dictionary once.
-	once.Do(func() {
-		r := strings.NewReader(cmudict)
-		dict, _ = loadDictionary(r)
-	})
-
-	words := strings.Fields(word)
-	var result []string
-
-	for _, w := range words {
-		// Clean and lowercase the word for dictionary lookup.
-		cleanWord := strings.ToLower(strings.Trim(w, ".,!?;:\"'()"))
-
-		if phonetic, ok := dict[cleanWord]; ok {
-			result = append(result, phonetic)
-		} else {
+	result, _, err := p.TransliterateSpans(word)
+	return result, err
+}
+
+// TransliterateSpans is Transliterate which also reports the span of each
+// word without the punctuation marks around. A span maps a word in the input
+// to its pronunciation in the output, such as "world" in "Hello, world!" to
+// "WERLD" in "HHAHLOW, WERLD!".
+func (p *english) TransliterateSpans(word string) (string, []hangulize.TranslitSpan, error) {
+	if p.dictErr != nil {
+		return "", nil, p.dictErr
+	}
+	if p.dict == nil && loadSnapshot().dict == nil {
+		return "", nil, ErrNoDict
+	}
+
+	tokens := p.analyze(word)
+	spans := make([]hangulize.TranslitSpan, 0, len(tokens))
+
+	var buf strings.Builder
+	end := 0
+	for _, tok := range tokens {
+		// The whitespace between the words.
+		buf.WriteString(word[end:tok.Offset])
+		end = tok.Offset + len(tok.Word)
+
+		lead, clean, trail := splitPuncts(tok.Word)
+		buf.WriteString(lead)
+
+		span := hangulize.TranslitSpan{
+			Start:    tok.Offset + len(lead),
+			Stop:     tok.Offset + len(lead) + len(clean),
+			OutStart: buf.Len(),
+		}
+		switch {
+		case tok.Known:
+			buf.WriteString(tok.Phonemes)
+		case tok.Spelling != "":
+			buf.WriteString(tok.Spelling)
+		default:
 			// If a word is not in the dictionary, pass it through as is.
-			result = append(result, w)
+			buf.WriteString(clean)
+		}
+		span.OutStop = buf.Len()
+
+		if span.Start != span.Stop {
+			spans = append(spans, span)
 		}
+		buf.WriteString(trail)
 	}
+	buf.WriteString(word[end:])
 
-	return strings.Join(result, " "), nil
+	if p.reportOOV {
+		if err := oovError(tokens); err != nil {
+			return buf.String(), spans, err
+		}
+	}
+	return buf.String(), spans, nil
 }
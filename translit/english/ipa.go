@@ -0,0 +1,53 @@
+package english
+
+import "strings"
+
+// IPA chooses whether to write the pronunciation in IPA instead of ARPAbet:
+// "hello" -> "həloʊ". With MarkStress, the stressed vowels are prefixed with
+// "ˈ" as in IPA. The stress numbers are not kept in IPA.
+func IPA(enabled bool) Option {
+	return func(p *english) { p.ipa = enabled }
+}
+
+// arpabetIPA maps ARPAbet phonemes without stress numbers to IPA in General
+// American.
+var arpabetIPA = map[string]string{
+	"AA": "ɑ", "AE": "æ", "AH": "ʌ", "AO": "ɔ", "AW": "aʊ",
+	"AY": "aɪ", "EH": "ɛ", "ER": "ɝ", "EY": "eɪ", "IH": "ɪ",
+	"IY": "i", "OW": "oʊ", "OY": "ɔɪ", "UH": "ʊ", "UW": "u",
+
+	"B": "b", "CH": "tʃ", "D": "d", "DH": "ð", "F": "f",
+	"G": "ɡ", "HH": "h", "JH": "dʒ", "K": "k", "L": "l",
+	"M": "m", "N": "n", "NG": "ŋ", "P": "p", "R": "ɹ",
+	"S": "s", "SH": "ʃ", "T": "t", "TH": "θ", "V": "v",
+	"W": "w", "Y": "j", "Z": "z", "ZH": "ʒ",
+}
+
+// britishIPA overrides arpabetIPA in Received Pronunciation.
+var britishIPA = map[string]string{
+	"ER": "ɜ",
+	"OW": "əʊ",
+}
+
+// reducedIPA maps the unstressed ARPAbet vowels which are reduced in IPA.
+var reducedIPA = map[string]string{
+	"AH0": "ə",
+	"ER0": "ɚ",
+}
+
+// toIPA converts an ARPAbet phoneme with or without a stress number to IPA
+// in General American or Received Pronunciation. An unknown phoneme remains.
+func toIPA(phoneme string, british bool) string {
+	if ipa, ok := reducedIPA[phoneme]; ok {
+		return ipa
+	}
+
+	bare := strings.TrimRight(phoneme, "012")
+	if ipa, ok := britishIPA[bare]; ok && british {
+		return ipa
+	}
+	if ipa, ok := arpabetIPA[bare]; ok {
+		return ipa
+	}
+	return phoneme
+}
@@ -0,0 +1,27 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestIPA(t *testing.T) {
+	ipa := english.New(english.IPA(true))
+
+	result, err := ipa.Transliterate("hello world")
+	require.NoError(t, err)
+	assert.Equal(t, "həloʊ wɝld", result)
+
+	result, err = ipa.Transliterate("thinking of judge")
+	require.NoError(t, err)
+	assert.Equal(t, "θɪŋkɪŋ ʌv dʒʌdʒ", result)
+
+	// With the stress marks and the syllables.
+	result, err = english.New(english.IPA(true), english.MarkStress(true), english.Syllabify(true)).Transliterate("banana")
+	require.NoError(t, err)
+	assert.Equal(t, "bə​nˈæ​nə", result)
+}
@@ -0,0 +1,216 @@
+package english
+
+import "strings"
+
+// LetterToSound chooses whether to guess the pronunciation of an unknown
+// word from its spelling by simple letter-to-sound rules, such as "c" before
+// "e", "i", or "y" -> "S", a silent final "e", and digraphs like "sh" or
+// "ph". The guess is plausible rather than correct, but better than passing
+// the word through as is. The words spelled by LetterNames are not guessed.
+func LetterToSound(enabled bool) Option {
+	return func(p *english) { p.letterToSound = enabled }
+}
+
+// letterRule is a rule to pronounce letters in ARPAbet without stress
+// numbers. An empty phonemes means silent letters.
+type letterRule struct {
+	letters  string
+	phonemes string
+
+	initial bool // only at the start of a word
+	final   bool // only at the end of a word
+	front   bool // only before a front vowel "e", "i", or "y"
+}
+
+// letterRules are tried in order at each position of a word. Longer letters
+// come first.
+var letterRules = []letterRule{
+	{letters: "tion", phonemes: "SH AH N"},
+	{letters: "sion", phonemes: "ZH AH N"},
+
+	{letters: "tch", phonemes: "CH"},
+	{letters: "dge", phonemes: "JH"},
+	{letters: "igh", phonemes: "AY"},
+	{letters: "sch", phonemes: "S K"},
+
+	{letters: "kn", phonemes: "N", initial: true},
+	{letters: "wr", phonemes: "R", initial: true},
+	{letters: "gh", phonemes: "G", initial: true},
+	{letters: "gh", phonemes: ""},
+	{letters: "ph", phonemes: "F"},
+	{letters: "sh", phonemes: "SH"},
+	{letters: "ch", phonemes: "CH"},
+	{letters: "th", phonemes: "TH"},
+	{letters: "wh", phonemes: "W"},
+	{letters: "ck", phonemes: "K"},
+	{letters: "ng", phonemes: "NG"},
+	{letters: "qu", phonemes: "K W"},
+	{letters: "ee", phonemes: "IY"},
+	{letters: "ea", phonemes: "IY"},
+	{letters: "oo", phonemes: "UW"},
+	{letters: "ou", phonemes: "AW"},
+	{letters: "ow", phonemes: "OW", final: true},
+	{letters: "ow", phonemes: "AW"},
+	{letters: "oi", phonemes: "OY"},
+	{letters: "oy", phonemes: "OY"},
+	{letters: "ai", phonemes: "EY"},
+	{letters: "ay", phonemes: "EY"},
+	{letters: "au", phonemes: "AO"},
+	{letters: "aw", phonemes: "AO"},
+	{letters: "ew", phonemes: "UW"},
+	{letters: "oa", phonemes: "OW"},
+	{letters: "ar", phonemes: "AA R"},
+	{letters: "or", phonemes: "AO R"},
+	{letters: "er", phonemes: "ER"},
+	{letters: "ir", phonemes: "ER"},
+	{letters: "ur", phonemes: "ER"},
+
+	{letters: "c", phonemes: "S", front: true},
+	{letters: "c", phonemes: "K"},
+	{letters: "g", phonemes: "JH", front: true},
+	{letters: "g", phonemes: "G"},
+	{letters: "y", phonemes: "Y", initial: true},
+	{letters: "y", phonemes: "IY", final: true},
+	{letters: "y", phonemes: "IH"},
+	{letters: "x", phonemes: "K S"},
+
+	{letters: "a", phonemes: "AE"},
+	{letters: "e", phonemes: "EH"},
+	{letters: "i", phonemes: "IH"},
+	{letters: "o", phonemes: "AA"},
+	{letters: "u", phonemes: "AH"},
+
+	{letters: "b", phonemes: "B"},
+	{letters: "d", phonemes: "D"},
+	{letters: "f", phonemes: "F"},
+	{letters: "h", phonemes: "HH"},
+	{letters: "j", phonemes: "JH"},
+	{letters: "k", phonemes: "K"},
+	{letters: "l", phonemes: "L"},
+	{letters: "m", phonemes: "M"},
+	{letters: "n", phonemes: "N"},
+	{letters: "p", phonemes: "P"},
+	{letters: "r", phonemes: "R"},
+	{letters: "s", phonemes: "S"},
+	{letters: "t", phonemes: "T"},
+	{letters: "v", phonemes: "V"},
+	{letters: "w", phonemes: "W"},
+	{letters: "z", phonemes: "Z"},
+}
+
+// longVowels are the pronunciations of the long vowels, such as "a" in "bate"
+// lengthened by the silent final "e".
+var longVowels = map[byte]string{
+	'a': "EY", 'e': "IY", 'i': "AY", 'o': "OW", 'u': "UW", 'y': "AY",
+}
+
+// match reports whether the rule matches with the letters at i in a word.
+func (r letterRule) match(word string, i int) bool {
+	if !strings.HasPrefix(word[i:], r.letters) {
+		return false
+	}
+	end := i + len(r.letters)
+
+	switch {
+	case r.initial && i != 0:
+		return false
+	case r.final && end != len(word):
+		return false
+	case r.front && (end == len(word) || !strings.ContainsRune("eiy", rune(word[end]))):
+		return false
+	}
+	return true
+}
+
+// isVowelLetter reports whether a letter is a vowel. "y" is not a vowel
+// here.
+func isVowelLetter(ch byte) bool {
+	return strings.IndexByte("aeiou", ch) >= 0
+}
+
+// guessPronunciation guesses the pronunciation of a word in ARPAbet from
+// the spelling by letterRules. The first vowel is stressed. It returns false
+// if the word has other than the Latin letters.
+func guessPronunciation(word string) (string, bool) {
+	word = strings.ToLower(word)
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return "", false
+		}
+	}
+
+	// A final "e" after a consonant is silent and lengthens the vowel
+	// before the consonant: "bate" -> "B EY T".
+	long := -1
+	if n := len(word); n > 2 && word[n-1] == 'e' && !isVowelLetter(word[n-2]) &&
+		strings.ContainsAny(word[:n-2], "aeiouy") {
+		if v := n - 3; strings.IndexByte("aeiouy", word[v]) >= 0 && (v == 0 || !isVowelLetter(word[v-1])) {
+			long = v
+		}
+		word = word[:n-1]
+	}
+
+	var phonemes []string
+	for i := 0; i < len(word); {
+		// A doubled consonant is pronounced once: "ll" -> "L".
+		if i != 0 && word[i] == word[i-1] && !isVowelLetter(word[i]) {
+			i++
+			continue
+		}
+
+		// A vowel before "-tion" or "-sion" is long except "i":
+		// "station" -> "S T EY SH AH N".
+		if i == long || strings.IndexByte("aeou", word[i]) >= 0 &&
+			(strings.HasPrefix(word[i+1:], "tion") || strings.HasPrefix(word[i+1:], "sion")) {
+			phonemes = append(phonemes, longVowels[word[i]])
+			i++
+			continue
+		}
+
+		for _, r := range letterRules {
+			if !r.match(word, i) {
+				continue
+			}
+
+			// A final "y" is "AY" in a word without other vowels: "fly".
+			phs := r.phonemes
+			if r.letters == "y" && r.final && !strings.ContainsAny(word, "aeiou") {
+				phs = "AY"
+			}
+
+			phonemes = append(phonemes, strings.Fields(phs)...)
+			i += len(r.letters)
+			break
+		}
+	}
+
+	// Stress the first vowel.
+	stressed := false
+	for i, ph := range phonemes {
+		if !vowels[ph] {
+			continue
+		}
+		if stressed {
+			phonemes[i] += "0"
+		} else {
+			phonemes[i] += "1"
+			stressed = true
+		}
+	}
+
+	return strings.Join(phonemes, " "), len(phonemes) != 0
+}
+
+// guess guesses the pronunciation of an unknown lowercase word if
+// LetterToSound is enabled.
+func (p *english) guess(key string) (string, bool) {
+	if !p.letterToSound {
+		return "", false
+	}
+
+	pron, ok := guessPronunciation(key)
+	if ok && p.british {
+		pron = toBritish(key, pron)
+	}
+	return pron, ok
+}
@@ -0,0 +1,53 @@
+package english
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuessPronunciation(t *testing.T) {
+	for word, want := range map[string]string{
+		"cat":     "K AE1 T",
+		"cinder":  "S IH1 N D ER0",
+		"gem":     "JH EH1 M",
+		"bate":    "B EY1 T",
+		"shine":   "SH AY1 N",
+		"phone":   "F OW1 N",
+		"knight":  "N AY1 T",
+		"fly":     "F L AY1",
+		"happy":   "HH AE1 P IY0",
+		"station": "S T EY1 SH AH0 N",
+		"bell":    "B EH1 L",
+	} {
+		pron, ok := guessPronunciation(word)
+		assert.True(t, ok, word)
+		assert.Equal(t, want, pron, word)
+	}
+
+	_, ok := guessPronunciation("r2d2")
+	assert.False(t, ok)
+}
+
+func TestLetterToSound(t *testing.T) {
+	p := New(LetterToSound(true))
+
+	result, err := p.Transliterate("the Glorbatt cinderfone")
+	assert.NoError(t, err)
+	assert.Equal(t, "DHAH GLAORBAET SIHNDERFOWN", result)
+
+	tokens := Analyze("Glorbatt hello", LetterToSound(true))
+	assert.True(t, tokens[0].Guessed)
+	assert.False(t, tokens[1].Guessed)
+
+	// The cached tokens are guessed too.
+	tokens = Analyze("blorfing blorfing", LetterToSound(true), CacheSize(10))
+	assert.True(t, tokens[0].Guessed)
+	assert.True(t, tokens[1].Guessed)
+	assert.Equal(t, 9, tokens[1].Offset)
+
+	// Disabled by default.
+	result, err = T.Transliterate("Glorbatt")
+	assert.NoError(t, err)
+	assert.Equal(t, "Glorbatt", result)
+}
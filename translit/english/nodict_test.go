@@ -0,0 +1,24 @@
+//go:build nodict
+
+package english_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestNoDict(t *testing.T) {
+	_, err := english.T.Transliterate("hello")
+	assert.ErrorIs(t, err, english.ErrNoDict)
+
+	// A dictionary provided at runtime.
+	p := english.New(english.WithDictReader(strings.NewReader("HELLO  HH AH0 L OW1\n")))
+	result, err := p.Transliterate("hello")
+	require.NoError(t, err)
+	assert.Equal(t, "HHAHLOW", result)
+}
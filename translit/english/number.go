@@ -0,0 +1,190 @@
+package english
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	ones = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+		"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+		"sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+		"eighty", "ninety",
+	}
+	scales = []string{"", "thousand", "million", "billion", "trillion"}
+
+	// irregularOrdinals are the ordinals which are not made by "-th".
+	irregularOrdinals = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// expandNumber spells out a numeric word in English words. It understands
+// cardinals ("1,024"), ordinals ("3rd"), years ("1999"), and decimals
+// ("3.14"). It returns false if the word is not a number.
+func expandNumber(word string) ([]string, bool) {
+	// Ordinals: "1st", "2nd", "3rd", "4th"
+	if len(word) > 2 {
+		digits, suffix := word[:len(word)-2], word[len(word)-2:]
+		switch suffix {
+		case "st", "nd", "rd", "th":
+			n, ok := parseCardinal(digits)
+			if !ok {
+				return nil, false
+			}
+			words := cardinal(n)
+			words[len(words)-1] = ordinal(words[len(words)-1])
+			return words, true
+		}
+	}
+
+	// Decimals: "3.14" -> "three point one four"
+	if whole, frac, ok := strings.Cut(word, "."); ok {
+		n, ok := parseCardinal(whole)
+		if !ok || frac == "" || !isDigits(frac) {
+			return nil, false
+		}
+		words := append(cardinal(n), "point")
+		for _, d := range frac {
+			words = append(words, ones[d-'0'])
+		}
+		return words, true
+	}
+
+	// Years: "1999" -> "nineteen ninety nine"
+	if len(word) == 4 && isDigits(word) {
+		if words, ok := year(word); ok {
+			return words, true
+		}
+	}
+
+	n, ok := parseCardinal(word)
+	if !ok {
+		return nil, false
+	}
+	return cardinal(n), true
+}
+
+// parseCardinal parses a non-negative integer which may have thousands
+// separators, such as "1,024".
+func parseCardinal(digits string) (uint64, bool) {
+	if strings.Contains(digits, ",") {
+		groups := strings.Split(digits, ",")
+		for i, g := range groups {
+			if i == 0 && (len(g) == 0 || len(g) > 3) || i != 0 && len(g) != 3 {
+				return 0, false
+			}
+		}
+		digits = strings.Join(groups, "")
+	}
+	if !isDigits(digits) {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || n >= 1e15 {
+		return 0, false
+	}
+	return n, true
+}
+
+// cardinal spells out a number less than a quadrillion without "and", as in
+// American English: 1024 -> "one thousand twenty four".
+func cardinal(n uint64) []string {
+	if n == 0 {
+		return []string{"zero"}
+	}
+
+	var groups [][]string
+	for scale := 0; n > 0; scale++ {
+		if g := n % 1000; g != 0 {
+			words := hundreds(int(g))
+			if scales[scale] != "" {
+				words = append(words, scales[scale])
+			}
+			groups = append(groups, words)
+		}
+		n /= 1000
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		words = append(words, groups[i]...)
+	}
+	return words
+}
+
+// hundreds spells out a number from 1 to 999.
+func hundreds(n int) []string {
+	var words []string
+	if n >= 100 {
+		words = append(words, ones[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, ones[n])
+	case n%10 == 0:
+		words = append(words, tens[n/10])
+	default:
+		words = append(words, tens[n/10], ones[n%10])
+	}
+	return words
+}
+
+// year spells out a year from 1100 to 2099 in pairs of digits, such as
+// "nineteen oh five" or "twenty twenty four". The years in 2000s before 2010
+// and the round thousands are read as cardinals.
+func year(digits string) ([]string, bool) {
+	n, _ := strconv.Atoi(digits)
+	if n < 1100 || n >= 2100 || n%1000 == 0 || n >= 2000 && n < 2010 {
+		return nil, false
+	}
+
+	hi, lo := n/100, n%100
+	words := hundreds(hi)
+	switch {
+	case lo == 0:
+		words = append(words, "hundred")
+	case lo < 10:
+		words = append(words, "oh", ones[lo])
+	default:
+		words = append(words, hundreds(lo)...)
+	}
+	return words, true
+}
+
+// ordinal converts the last word of a cardinal into an ordinal.
+func ordinal(word string) string {
+	if ord, ok := irregularOrdinals[word]; ok {
+		return ord
+	}
+	if strings.HasSuffix(word, "y") {
+		return strings.TrimSuffix(word, "y") + "ieth"
+	}
+	return word + "th"
+}
+
+// isDigits reports whether a string consists of only ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
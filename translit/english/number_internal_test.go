@@ -0,0 +1,45 @@
+package english
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNumber(t *testing.T) {
+	for word, want := range map[string]string{
+		"0":         "zero",
+		"7":         "seven",
+		"15":        "fifteen",
+		"40":        "forty",
+		"99":        "ninety nine",
+		"100":       "one hundred",
+		"1,024":     "one thousand twenty four",
+		"1000000":   "one million",
+		"2,000,017": "two million seventeen",
+		"1st":       "first",
+		"3rd":       "third",
+		"12th":      "twelfth",
+		"20th":      "twentieth",
+		"101st":     "one hundred first",
+		"1999":      "nineteen ninety nine",
+		"1905":      "nineteen oh five",
+		"1900":      "nineteen hundred",
+		"2024":      "twenty twenty four",
+		"2005":      "two thousand five",
+		"2000":      "two thousand",
+		"3.14":      "three point one four",
+		"0.5":       "zero point five",
+	} {
+		words, ok := expandNumber(word)
+		if assert.True(t, ok, word) {
+			assert.Equal(t, want, strings.Join(words, " "), word)
+		}
+	}
+
+	for _, word := range []string{"", "abc", "1,00", "3.", ".5", "1.2.3", "4x4", "th", "12ab"} {
+		_, ok := expandNumber(word)
+		assert.False(t, ok, word)
+	}
+}
@@ -0,0 +1,45 @@
+package english
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReportOOV chooses whether Transliterate reports the words out of
+// vocabulary as an *OOVError. The words are still passed through as is in
+// the result so that the caller may use it with the error.
+func ReportOOV(enabled bool) Option {
+	return func(p *english) { p.reportOOV = enabled }
+}
+
+// OOVError lists the words which Transliterate could not pronounce and
+// passed through as is. The words spelled by LetterNames or guessed by
+// LetterToSound are not included.
+type OOVError struct {
+	// Words are the tokens of the words out of vocabulary. Their offsets are
+	// the byte offsets in the input.
+	Words []Token
+}
+
+func (e *OOVError) Error() string {
+	words := make([]string, len(e.Words))
+	for i, tok := range e.Words {
+		words[i] = fmt.Sprintf("%q at %d", tok.Word, tok.Offset)
+	}
+	return fmt.Sprintf("english: out of vocabulary: %s", strings.Join(words, ", "))
+}
+
+// oovError collects the words out of vocabulary in tokens. It returns nil if
+// there is none.
+func oovError(tokens []Token) error {
+	var oov []Token
+	for _, tok := range tokens {
+		if !tok.Known && tok.Spelling == "" {
+			oov = append(oov, tok)
+		}
+	}
+	if len(oov) == 0 {
+		return nil
+	}
+	return &OOVError{oov}
+}
@@ -0,0 +1,59 @@
+package english_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestReportOOV(t *testing.T) {
+	strict := english.New(english.ReportOOV(true))
+
+	result, err := strict.Transliterate("I met Zzxq and Blorfington.")
+	assert.Equal(t, "AY MEHT Zzxq AHND Blorfington.", result)
+
+	var oov *english.OOVError
+	require.ErrorAs(t, err, &oov)
+	require.Len(t, oov.Words, 2)
+	assert.Equal(t, "Zzxq", oov.Words[0].Word)
+	assert.Equal(t, 6, oov.Words[0].Offset)
+	assert.Equal(t, "Blorfington.", oov.Words[1].Word)
+	assert.Equal(t, 15, oov.Words[1].Offset)
+	assert.EqualError(t, err, `english: out of vocabulary: "Zzxq" at 6, "Blorfington." at 15`)
+
+	// No error without words out of vocabulary.
+	_, err = strict.Transliterate("hello world")
+	assert.NoError(t, err)
+
+	// The words spelled by the letter names are not out of vocabulary.
+	_, err = english.New(english.ReportOOV(true), english.LetterNames(true)).Transliterate("XJ9")
+	assert.NoError(t, err)
+
+	// Disabled by default.
+	_, err = english.T.Transliterate("Zzxq")
+	assert.NoError(t, err)
+}
+
+func TestReportOOVHangulize(t *testing.T) {
+	spec, err := hangulize.ParseSpec(strings.NewReader(`
+	lang:
+		id       = "eng-oov"
+		codes    = "en", "eng"
+		translit = "english"
+	`))
+	require.NoError(t, err)
+
+	h := hangulize.New(spec)
+	h.UseTranslit(english.New(english.ReportOOV(true)))
+
+	_, err = h.Hangulize("Zzxq")
+	assert.ErrorIs(t, err, hangulize.ErrTranslit)
+
+	var oov *english.OOVError
+	assert.ErrorAs(t, err, &oov)
+}
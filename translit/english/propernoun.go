@@ -0,0 +1,9 @@
+package english
+
+// ProperNouns chooses whether to use the supplementary dictionary of
+// personal names, place names, and brands which CMUdict lacks or pronounces
+// poorly, such as "Kyiv" or "Xiaomi". The dictionary is preferred over
+// CMUdict regardless of the letter case.
+func ProperNouns(enabled bool) Option {
+	return func(p *english) { p.properNouns = enabled }
+}
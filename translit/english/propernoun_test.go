@@ -0,0 +1,28 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestProperNounsDict(t *testing.T) {
+	names := english.New(english.ProperNouns(true))
+
+	result, err := names.Transliterate("Kyiv Xiaomi busan")
+	require.NoError(t, err)
+	assert.Equal(t, "KIYIHV SHAWMIY BUWSAAN", result)
+
+	// Preferred over CMUdict.
+	result, err = names.Transliterate("Goethe")
+	require.NoError(t, err)
+	assert.Equal(t, "GERTAH", result)
+
+	// Disabled by default.
+	result, err = english.T.Transliterate("Kyiv Goethe")
+	require.NoError(t, err)
+	assert.Equal(t, "Kyiv GOWTH", result)
+}
@@ -0,0 +1,129 @@
+package english
+
+import (
+	"bytes"
+	"io"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// snapshot is an immutable set of the dictionaries shared by all English
+// Translits. It is never modified after published. A change makes a new
+// snapshot and swaps it atomically so that a transliteration in progress
+// keeps using the snapshot it started with.
+type snapshot struct {
+	// dict is CMUdict. It is the embedded one unless replaced by Reload.
+	dict        *dictionary
+	dictVersion string
+
+	names       map[string]string
+	properNouns map[string]string
+	british     map[string]string
+
+	// phrases are the pronunciations of the phrases of several words, such
+	// as "ice cream". phraseLen is the maximum number of words in a phrase
+	// in phrases or user.
+	phrases   map[string]string
+	phraseLen int
+
+	// user is the pronunciations added by AddPronunciation and
+	// LoadUserDict. They override the other dictionaries.
+	user map[string]string
+}
+
+var (
+	current atomic.Pointer[snapshot]
+	once    sync.Once
+
+	// updateMu serializes the updates of the snapshot.
+	updateMu sync.Mutex
+)
+
+// loadSnapshot returns the current snapshot. The embedded dictionaries are
+// loaded on the first call.
+func loadSnapshot() *snapshot {
+	once.Do(func() {
+		s := &snapshot{
+			dictVersion: "unknown",
+			user:        make(map[string]string),
+		}
+		if r, err := openCMUdict(); err == nil {
+			s.dict, _ = readDictionary(r)
+		}
+		if r, err := openCMUdict(); err == nil {
+			s.dictVersion = cmudictVersion(r)
+		}
+		s.names, _ = loadDictionary(strings.NewReader(namesDict))
+		s.properNouns, _ = loadDictionary(strings.NewReader(properNounsDict))
+		s.british, _ = loadDictionary(strings.NewReader(britishDict))
+		s.phrases, _ = loadDictionary(strings.NewReader(phrasesDict))
+		s.phraseLen = max(phraseLen(s.phrases), 1)
+		current.Store(s)
+	})
+	return current.Load()
+}
+
+// update publishes a new snapshot modified by fn from a shallow copy of the
+// current one. fn must not modify the maps in place but replace them.
+func update(fn func(s *snapshot)) {
+	updateMu.Lock()
+	defer updateMu.Unlock()
+
+	s := *loadSnapshot()
+	fn(&s)
+	current.Store(&s)
+}
+
+// Reload replaces the CMUdict of all English Translits with a dictionary in
+// the same format from r, such as an updated release of CMUdict. It is safe
+// to call it concurrently with transliteration: a transliteration in
+// progress completes with the old dictionary. The Translits made with
+// WithDictReader or WithDictFile keep their own dictionaries.
+func Reload(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	dict := newDictionary(string(data))
+	version := cmudictVersion(bytes.NewReader(data))
+
+	update(func(s *snapshot) {
+		s.dict = dict
+		s.dictVersion = version
+	})
+	return nil
+}
+
+// addUser publishes a new snapshot with the pronunciations added to the
+// user dictionary.
+func addUser(prons map[string]string) {
+	update(func(s *snapshot) {
+		user := maps.Clone(s.user)
+		maps.Copy(user, prons)
+		s.user = user
+		s.phraseLen = max(s.phraseLen, phraseLen(prons))
+	})
+}
+
+// phraseLen finds the maximum number of words in the keys of a dictionary.
+func phraseLen(dict map[string]string) int {
+	n := 0
+	for key := range dict {
+		n = max(n, strings.Count(key, " ")+1)
+	}
+	return n
+}
+
+// hasPhrase reports whether a phrase of several words is in the phrase or
+// user dictionary. The phrase may end with the possessive "'s".
+func (s *snapshot) hasPhrase(key string) bool {
+	key = strings.TrimSuffix(key, "'s")
+	if _, ok := s.user[key]; ok {
+		return true
+	}
+	_, ok := s.phrases[key]
+	return ok
+}
@@ -0,0 +1,61 @@
+package english
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reloadedDict = `;;; # cmudict-9.9z
+HELLO  HH EH1 L OW0
+WORLD  W ER1 L D
+`
+
+func TestReload(t *testing.T) {
+	embedded := loadSnapshot()
+	defer current.Store(embedded)
+
+	require.NoError(t, Reload(strings.NewReader(reloadedDict)))
+
+	result, err := T.Transliterate("hello world")
+	require.NoError(t, err)
+	assert.Equal(t, "HHEHLOW WERLD", result)
+
+	assert.Equal(t, "9.9z", T.(*english).Datasets()[0].Version)
+
+	// The old snapshot is intact.
+	pron, ok := embedded.dict.lookup("hello")
+	assert.True(t, ok)
+	assert.Equal(t, "HH AH0 L OW1", pron)
+}
+
+func TestReloadRace(t *testing.T) {
+	embedded := loadSnapshot()
+	defer current.Store(embedded)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				result, err := T.Transliterate("hello world")
+				assert.NoError(t, err)
+				assert.Contains(t, []string{"HHAHLOW WERLD", "HHEHLOW WERLD"}, result)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				assert.NoError(t, Reload(strings.NewReader(reloadedDict)))
+				AddPronunciation("snapshotrace", "S N AE1 P")
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []string{"S N AE1 P"}, Pronunciations("snapshotrace"))
+}
@@ -0,0 +1,70 @@
+package english
+
+import (
+	"strings"
+	"unicode"
+)
+
+// LetterNames chooses whether to spell a word by the names of the letters in
+// Hangul when the word is not in the dictionaries and seems not to be
+// pronounceable, such as a product code "XJ9" -> "엑스제이나인". Otherwise, such
+// a word is passed through as is.
+func LetterNames(enabled bool) Option {
+	return func(p *english) { p.letterNames = enabled }
+}
+
+// letterNames are the Korean names of the Latin letters and digits.
+var letterNames = map[rune]string{
+	'a': "에이", 'b': "비", 'c': "시", 'd': "디", 'e': "이", 'f': "에프",
+	'g': "지", 'h': "에이치", 'i': "아이", 'j': "제이", 'k': "케이", 'l': "엘",
+	'm': "엠", 'n': "엔", 'o': "오", 'p': "피", 'q': "큐", 'r': "아르",
+	's': "에스", 't': "티", 'u': "유", 'v': "브이", 'w': "더블유", 'x': "엑스",
+	'y': "와이", 'z': "제트",
+
+	'0': "제로", '1': "원", '2': "투", '3': "스리", '4': "포", '5': "파이브",
+	'6': "식스", '7': "세븐", '8': "에이트", '9': "나인",
+}
+
+// Spell spells a word by the Korean names of the letters and digits. The
+// other characters remain.
+func Spell(word string) string {
+	var buf strings.Builder
+	for _, r := range word {
+		if name, ok := letterNames[unicode.ToLower(r)]; ok {
+			buf.WriteString(name)
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// isUnpronounceable reports whether an unknown word should be spelled rather
+// than pronounced. A word with digits, an acronym in uppercase, or a word
+// without vowels is unpronounceable.
+func isUnpronounceable(word string) bool {
+	hasLetter, hasVowel, hasDigit, hasLower := false, false, false, false
+
+	for _, r := range word {
+		if _, ok := letterNames[unicode.ToLower(r)]; !ok {
+			if r != '-' {
+				return false
+			}
+			continue
+		}
+
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case strings.ContainsRune("aeiouyAEIOUY", r):
+			hasLetter, hasVowel = true, true
+		default:
+			hasLetter = true
+		}
+		if unicode.IsLower(r) {
+			hasLower = true
+		}
+	}
+
+	return hasDigit || hasLetter && (!hasVowel || !hasLower)
+}
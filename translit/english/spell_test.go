@@ -0,0 +1,33 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestSpell(t *testing.T) {
+	assert.Equal(t, "엑스제이나인", english.Spell("XJ9"))
+	assert.Equal(t, "비-투", english.Spell("B-2"))
+}
+
+func TestLetterNames(t *testing.T) {
+	spell := english.New(english.LetterNames(true))
+
+	result, err := spell.Transliterate("the XJ9 robot")
+	require.NoError(t, err)
+	assert.Equal(t, "DHAH 엑스제이나인 ROWBAAT", result)
+
+	// A pronounceable unknown word is not spelled.
+	result, err = spell.Transliterate("Blorfington")
+	require.NoError(t, err)
+	assert.Equal(t, "Blorfington", result)
+
+	// Disabled by default.
+	result, err = english.T.Transliterate("XJ9")
+	require.NoError(t, err)
+	assert.Equal(t, "XJ9", result)
+}
@@ -0,0 +1,34 @@
+package english
+
+import (
+	"strings"
+
+	"github.com/hangulize/hangulize"
+)
+
+// MarkStress chooses whether to put hangulize.StressMark before the vowels
+// with the primary stress: "hello" -> "HHAHLˈOW". Then hangulize.Align
+// reports which syllables in the result are stressed.
+func MarkStress(enabled bool) Option {
+	return func(p *english) { p.markStress = enabled }
+}
+
+// KeepStress chooses whether to keep the stress numbers of the vowels in
+// ARPAbet: "hello" -> "HHAH0LOW1". Then a spec can transcribe the reduced
+// vowels, such as "AH0", differently from the stressed ones.
+func KeepStress(enabled bool) Option {
+	return func(p *english) { p.keepStress = enabled }
+}
+
+// joinStressed joins phonemes with the stress marks before the stressed
+// ones.
+func joinStressed(phonemes []string, stressed []bool) string {
+	var buf strings.Builder
+	for i, ph := range phonemes {
+		if stressed[i] {
+			buf.WriteRune(hangulize.StressMark)
+		}
+		buf.WriteString(ph)
+	}
+	return buf.String()
+}
@@ -0,0 +1,86 @@
+package english
+
+import (
+	"strings"
+)
+
+// Syllabify chooses whether to separate syllables in ARPAbet with U+200B
+// Zero Width Spaces like the Pinyin Translit. Then "^" and "$" in a spec
+// match with the edges of each syllable. It helps to decide epenthetic vowels
+// by the syllable structure, e.g., "S T R AY K" is a single syllable.
+func Syllabify(enabled bool) Option {
+	return func(p *english) { p.syllabify = enabled }
+}
+
+// vowels are the syllabic ARPAbet phonemes.
+var vowels = map[string]bool{
+	"AA": true, "AE": true, "AH": true, "AO": true, "AW": true,
+	"AY": true, "EH": true, "ER": true, "EY": true, "IH": true,
+	"IY": true, "OW": true, "OY": true, "UH": true, "UW": true,
+}
+
+// legalOnsets are the consonant clusters which may begin an English
+// syllable. Single consonants except "NG" are legal too.
+var legalOnsets = map[string]bool{
+	"P R": true, "B R": true, "T R": true, "D R": true, "K R": true,
+	"G R": true, "F R": true, "TH R": true, "SH R": true,
+
+	"P L": true, "B L": true, "K L": true, "G L": true, "F L": true,
+	"S L": true,
+
+	"T W": true, "D W": true, "K W": true, "G W": true, "TH W": true,
+	"S W": true,
+
+	"P Y": true, "B Y": true, "F Y": true, "V Y": true, "M Y": true,
+	"K Y": true, "G Y": true, "HH Y": true,
+
+	"S P": true, "S T": true, "S K": true, "S M": true, "S N": true,
+
+	"S P R": true, "S T R": true, "S K R": true, "S P L": true,
+	"S K W": true, "S P Y": true, "S K Y": true,
+}
+
+// isLegalOnset reports whether the consonants may begin a syllable.
+func isLegalOnset(consonants []string) bool {
+	switch len(consonants) {
+	case 0:
+		return true
+	case 1:
+		return consonants[0] != "NG"
+	}
+	return legalOnsets[strings.Join(consonants, " ")]
+}
+
+// syllabify groups phonemes without stress numbers into syllables by the
+// maximal onset principle: the consonants between vowels belong to the
+// following syllable as many as they form a legal onset.
+func syllabify(phonemes []string) [][]string {
+	var nuclei []int
+	for i, ph := range phonemes {
+		if vowels[ph] {
+			nuclei = append(nuclei, i)
+		}
+	}
+	if len(nuclei) < 2 {
+		return [][]string{phonemes}
+	}
+
+	var syllables [][]string
+	start := 0
+
+	for k := 1; k < len(nuclei); k++ {
+		// The consonants between two vowels.
+		prev, next := nuclei[k-1], nuclei[k]
+
+		split := next
+		for split > prev+1 && isLegalOnset(phonemes[split-1:next]) {
+			split--
+		}
+
+		syllables = append(syllables, phonemes[start:split])
+		start = split
+	}
+	syllables = append(syllables, phonemes[start:])
+
+	return syllables
+}
@@ -0,0 +1,71 @@
+package english
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+)
+
+func syllables(pron string) []string {
+	var result []string
+	for _, syl := range syllabify(strings.Fields(pron)) {
+		result = append(result, strings.Join(syl, " "))
+	}
+	return result
+}
+
+func TestSyllabify(t *testing.T) {
+	assert.Equal(t, []string{"S T R AY K"}, syllables("S T R AY K"))
+	assert.Equal(t, []string{"HH AH", "L OW"}, syllables("HH AH L OW"))
+	assert.Equal(t, []string{"EH K", "S T R AH"}, syllables("EH K S T R AH"))
+	assert.Equal(t, []string{"K AH N", "S T R AH K T"}, syllables("K AH N S T R AH K T"))
+	assert.Equal(t, []string{"AH T", "L AE N", "T IH K"}, syllables("AH T L AE N T IH K"))
+	assert.Equal(t, []string{"S IH NG", "ER"}, syllables("S IH NG ER"))
+}
+
+func TestSyllabifyTranslit(t *testing.T) {
+	result, err := New(Syllabify(true)).Transliterate("Hello strike")
+	assert.NoError(t, err)
+	assert.Equal(t, "HHAH\u200bLOW STRAYK", result)
+
+	result, err = T.Transliterate("Hello strike")
+	assert.NoError(t, err)
+	assert.Equal(t, "HHAHLOW STRAYK", result)
+}
+
+func TestSyllabifySpec(t *testing.T) {
+	// A coda is written as a tail while an onset is followed by a vowel or
+	// "ㅡ" epenthesis: "napkin" -> "냅킨" but not "내프킨".
+	spec, err := hangulize.ParseSpec(strings.NewReader(`
+	lang:
+		id       = "eng-syllable"
+		codes    = "en", "eng"
+		translit = "english"
+
+	transcribe:
+		"p$" -> "-ㅂ"
+		"p"  -> "프"
+		"k"  -> "ㅋ"
+		"n$" -> "-ㄴ"
+		"n"  -> "ㄴ"
+		"ae" -> "ㅐ"
+		"ih" -> "ㅣ"
+	`))
+	require.NoError(t, err)
+
+	h := hangulize.New(spec)
+	h.UseTranslit(New(Syllabify(true)))
+	result, err := h.Hangulize("napkin")
+	require.NoError(t, err)
+	assert.Equal(t, "냅킨", result)
+
+	h = hangulize.New(spec)
+	h.UseTranslit(New())
+	result, err = h.Hangulize("napkin")
+	require.NoError(t, err)
+	assert.Equal(t, "내프킨", result)
+}
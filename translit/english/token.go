@@ -0,0 +1,276 @@
+package english
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token is a word analyzed by the English Translit.
+type Token struct {
+	// Word is the word as is in the input. A phrase in the dictionaries is
+	// a single token, such as "New York" including the whitespace.
+	Word string
+
+	// Offset is the byte offset of the word in the input.
+	Offset int
+
+	// Phonemes is the pronunciation in ARPAbet. It is empty if the word is
+	// unknown. A number is spelled out in several words separated by spaces,
+	// such as "TWEHNTIY TWEHNTIY FAOR" for "2024".
+	Phonemes string
+
+	// Known is true if the pronunciation of the word is found.
+	Known bool
+
+	// Guessed is true if the pronunciation is guessed from the spelling by
+	// LetterToSound.
+	Guessed bool
+
+	// Initialism is true if the word is an unknown initialism pronounced
+	// letter by letter, such as "FBI". An acronym pronounceable as a word,
+	// such as "NASA", is not an initialism.
+	Initialism bool
+
+	// Spelling is the Korean names of the letters in an unknown word. It is
+	// filled only if the LetterNames option is enabled.
+	Spelling string
+
+	// ProperNoun is true if the word is capitalized in the middle of a
+	// sentence, such as "Nice" in "I live in Nice". The pronunciation of a
+	// proper noun prefers the name dictionary over the common words.
+	ProperNoun bool
+}
+
+// Analyze splits a text into words and looks up their pronunciations as the
+// English Translit does. The tokens tell which words are treated as proper
+// nouns.
+func Analyze(text string, opts ...Option) []Token {
+	p := New(opts...).(*english)
+	return p.analyze(text)
+}
+
+// analyze splits a text into words and looks up their pronunciations. All
+// the words are looked up in the same snapshot of the dictionaries.
+func (p *english) analyze(text string) []Token {
+	s := loadSnapshot()
+
+	words, offsets := fields(text)
+	tokens := make([]Token, 0, len(words))
+
+	sentenceHead := true
+	for i := 0; i < len(words); i++ {
+		w, offset := words[i], offsets[i]
+		if n := matchPhrase(s, words, i); n > 1 {
+			// Merge the words in a phrase into a token.
+			i += n - 1
+			w = text[offset : offsets[i]+len(words[i])]
+		}
+		_, cleanWord, _ := splitPuncts(w)
+
+		tok := Token{Word: w, Offset: offset}
+		tok.ProperNoun = !sentenceHead && isCapitalized(cleanWord)
+		p.pronounce(s, &tok, cleanWord, words, i)
+
+		tokens = append(tokens, tok)
+		sentenceHead = strings.ContainsAny(w[len(w)-1:], ".!?")
+	}
+
+	return tokens
+}
+
+// matchPhrase finds the longest phrase in the dictionaries from words[i]. It
+// returns the number of the words in the phrase, or 0 if there's no phrase.
+// The punctuation marks may be only around the phrase.
+func matchPhrase(s *snapshot, words []string, i int) int {
+	for n := min(s.phraseLen, len(words)-i); n > 1; n-- {
+		parts := make([]string, n)
+		for j, w := range words[i : i+n] {
+			lead, clean, trail := splitPuncts(w)
+			if j != 0 && lead != "" || j != n-1 && trail != "" || clean == "" {
+				parts = nil
+				break
+			}
+			parts[j] = lookupKey(clean)
+		}
+
+		if parts != nil && s.hasPhrase(strings.Join(parts, " ")) {
+			return n
+		}
+	}
+	return 0
+}
+
+// pronounce fills the pronunciation of a token. cleanWord is the word
+// without the punctuation marks around. The result is memoized in the cache
+// if enabled.
+func (p *english) pronounce(s *snapshot, tok *Token, cleanWord string, words []string, i int) {
+	// The pronunciation depends on the context with Disambiguate.
+	useCache := p.cache != nil && p.disambiguate == nil
+
+	cacheKey := cleanWord
+	if tok.ProperNoun {
+		cacheKey = "\x00" + cleanWord
+	}
+	if useCache {
+		if cached, ok := p.cache.get(s, cacheKey); ok {
+			// Only the position and the context are of this token.
+			cached.Word = tok.Word
+			cached.Offset = tok.Offset
+			cached.ProperNoun = tok.ProperNoun
+			*tok = cached
+			return
+		}
+	}
+
+	key := lookupKey(cleanWord)
+
+	if nums, ok := expandNumber(key); ok {
+		tok.Phonemes, tok.Known = p.pronounceWords(s, nums)
+	} else if pron, ok := p.lookup(s, key, tok.ProperNoun, words, i); ok {
+		tok.Phonemes = p.format(strings.Fields(pron))
+		tok.Known = true
+	} else if pron, ok := spellInitialism(cleanWord); ok {
+		if p.british {
+			pron = toBritish(key, pron)
+		}
+		tok.Phonemes = p.format(strings.Fields(pron))
+		tok.Known = true
+		tok.Initialism = true
+	} else if p.letterNames && isUnpronounceable(cleanWord) {
+		tok.Spelling = Spell(cleanWord)
+	} else if pron, ok := p.guess(key); ok {
+		tok.Phonemes = p.format(strings.Fields(pron))
+		tok.Known = true
+		tok.Guessed = true
+	}
+
+	if useCache {
+		p.cache.put(s, cacheKey, *tok)
+	}
+}
+
+// lookup finds the pronunciation of a lowercase word in the user dictionary,
+// the British dictionary if enabled, the name dictionary for a proper noun,
+// the proper noun dictionary if enabled, and the dictionary of the Translit
+// or the snapshot in order. The pronunciations in General American are
+// transformed to Received Pronunciation if British is enabled. If the word
+// is not found, it is pronounced by its parts as a compound.
+func (p *english) lookup(s *snapshot, key string, proper bool, words []string, i int) (string, bool) {
+	if pron, ok := s.user[key]; ok {
+		return pron, true
+	}
+	if p.british {
+		if pron, ok := s.british[key]; ok {
+			return pron, true
+		}
+	}
+
+	if pron, ok := p.lookupAmerican(s, key, proper, words, i); ok {
+		if p.british {
+			pron = toBritish(key, pron)
+		}
+		return pron, true
+	}
+	return p.lookupCompound(s, key, proper, words, i)
+}
+
+// lookupAmerican finds the pronunciation of a lowercase word in the
+// dictionaries in General American.
+func (p *english) lookupAmerican(s *snapshot, key string, proper bool, words []string, i int) (string, bool) {
+	if proper {
+		if pron, ok := s.names[key]; ok {
+			return pron, true
+		}
+	}
+	if p.properNouns {
+		if pron, ok := s.properNouns[key]; ok {
+			return pron, true
+		}
+	}
+	if pron, ok := s.phrases[key]; ok {
+		return pron, true
+	}
+	return p.lookupDict(s, key, words, i)
+}
+
+// pronounceWords looks up the pronunciations of the words spelled out from a
+// number. The words are separated by spaces. It fails if any of the words is
+// not found.
+func (p *english) pronounceWords(s *snapshot, words []string) (string, bool) {
+	prons := make([]string, len(words))
+	for i, w := range words {
+		pron, ok := p.lookup(s, w, false, words, i)
+		if !ok {
+			return "", false
+		}
+		prons[i] = p.format(strings.Fields(pron))
+	}
+	return strings.Join(prons, " "), true
+}
+
+// fields splits a text around whitespace like strings.Fields but also
+// returns the byte offsets of the words. The non-breaking spaces such as
+// U+00A0 are whitespace too.
+func fields(text string) ([]string, []int) {
+	var (
+		words   []string
+		offsets []int
+	)
+
+	start := -1
+	for i, r := range text {
+		switch {
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		case unicode.IsSpace(r) && start >= 0:
+			words = append(words, text[start:i])
+			offsets = append(offsets, start)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, text[start:])
+		offsets = append(offsets, start)
+	}
+
+	return words, offsets
+}
+
+// puncts are the punctuation marks around words which are not pronounced.
+// The typographic quotes are included.
+const puncts = ".,!?;:\"'()“”„‘’‚«»‹›"
+
+// apostrophes replaces the typographic apostrophes in a word with the ASCII
+// apostrophe as in the dictionaries: "don’t" -> "don't".
+var apostrophes = strings.NewReplacer("’", "'", "‘", "'", "ʼ", "'", "´", "'", "`", "'")
+
+// lookupKey makes the key of a clean word for dictionary lookup. It is
+// lowercase with the ASCII apostrophes. The words in a phrase are separated
+// by single spaces.
+func lookupKey(cleanWord string) string {
+	key := apostrophes.Replace(strings.ToLower(cleanWord))
+	if strings.ContainsFunc(key, unicode.IsSpace) {
+		key = strings.Join(strings.Fields(key), " ")
+	}
+	return key
+}
+
+// splitPuncts splits a word into the leading punctuation marks, the clean
+// word, and the trailing punctuation marks: "(Hello," -> "(", "Hello", ",".
+func splitPuncts(word string) (string, string, string) {
+	clean := strings.TrimLeft(word, puncts)
+	lead := word[:len(word)-len(clean)]
+	clean = strings.TrimRight(clean, puncts)
+	trail := word[len(lead)+len(clean):]
+	return lead, clean, trail
+}
+
+// isCapitalized reports whether a word starts with an uppercase letter but
+// is not all in uppercase, such as "Nice" or "McDonald" but not "NICE" or
+// "nice".
+func isCapitalized(word string) bool {
+	for _, r := range word {
+		return unicode.IsUpper(r) && word != strings.ToUpper(word)
+	}
+	return false
+}
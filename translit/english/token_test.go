@@ -0,0 +1,201 @@
+package english_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestProperNouns(t *testing.T) {
+	tokens := english.Analyze("Nice weather in Nice. Reading is reading")
+
+	var proper []string
+	for _, tok := range tokens {
+		if tok.ProperNoun {
+			proper = append(proper, tok.Word)
+		}
+	}
+	assert.Equal(t, []string{"Nice."}, proper)
+
+	// The name dictionary is preferred for proper nouns.
+	assert.Equal(t, "NAYS", tokens[0].Phonemes)
+	assert.Equal(t, "NIYS", tokens[3].Phonemes)
+
+	// The head of a sentence is not a proper noun.
+	assert.Equal(t, "RIYDIHNG", tokens[4].Phonemes)
+}
+
+func TestUnknownToken(t *testing.T) {
+	tokens := english.Analyze("Zzxq")
+	assert.False(t, tokens[0].Known)
+	assert.Empty(t, tokens[0].Phonemes)
+
+	result, err := english.T.Transliterate("I met Herb")
+	assert.NoError(t, err)
+	assert.Equal(t, "AY MEHT HHERB", result)
+}
+
+func TestMarkStress(t *testing.T) {
+	result, err := english.New(english.MarkStress(true)).Transliterate("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "HHAHLˈOW", result)
+
+	result, err = english.New(english.MarkStress(true), english.Syllabify(true)).Transliterate("banana")
+	assert.NoError(t, err)
+	assert.Equal(t, "BAH\u200bNˈAE\u200bNAH", result)
+}
+
+func TestKeepStress(t *testing.T) {
+	result, err := english.New(english.KeepStress(true)).Transliterate("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "HHAH0LOW1", result)
+
+	result, err = english.New(english.KeepStress(true), english.Syllabify(true)).Transliterate("banana")
+	assert.NoError(t, err)
+	assert.Equal(t, "BAH0​NAE1​NAH0", result)
+
+	// A spec maps the reduced vowels differently.
+	spec, err := hangulize.ParseSpec(strings.NewReader(`
+	lang:
+		id       = "eng-stress"
+		codes    = "en", "eng"
+		translit = "english"
+
+	transcribe:
+		"b"   -> "ㅂ"
+		"n"   -> "ㄴ"
+		"ae1" -> "ㅐ"
+		"ah0" -> "ㅓ"
+		"ah1" -> "ㅏ"
+	`))
+	require.NoError(t, err)
+
+	h := hangulize.New(spec)
+	h.UseTranslit(english.New(english.KeepStress(true)))
+
+	result, err = h.Hangulize("banana")
+	require.NoError(t, err)
+	assert.Equal(t, "버내너", result)
+}
+
+func TestCompoundToken(t *testing.T) {
+	// Hyphenated compounds are pronounced part by part.
+	result, err := english.T.Transliterate("data-driven")
+	assert.NoError(t, err)
+	assert.Equal(t, "DEYTAHDRIHVAHN", result)
+
+	// The possessive 's is /s/, /z/, or /ɪz/ by the last phoneme.
+	result, err = english.T.Transliterate("Bach's Beethoven's quiche's")
+	assert.NoError(t, err)
+	assert.Equal(t, "BAAKS BEYTOWVAHNZ KIYSHIHZ", result)
+
+	// Contractions are pronounced as the base words with the clitics.
+	result, err = english.T.Transliterate("they'd've")
+	assert.NoError(t, err)
+	assert.Equal(t, "DHEYDAHV", result)
+
+	tokens := english.Analyze("well-Zzxq")
+	assert.False(t, tokens[0].Known)
+}
+
+func TestNumberToken(t *testing.T) {
+	result, err := english.T.Transliterate("the 3rd of 2024")
+	assert.NoError(t, err)
+	assert.Equal(t, "DHAH THERD AHV TWEHNTIY TWEHNTIY FAOR", result)
+
+	tokens := english.Analyze("1.5")
+	assert.True(t, tokens[0].Known)
+	assert.Equal(t, "WAHN POYNT FAYV", tokens[0].Phonemes)
+}
+
+func TestPunctuation(t *testing.T) {
+	result, err := english.T.Transliterate("Hello, world!")
+	assert.NoError(t, err)
+	assert.Equal(t, "HHAHLOW, WERLD!", result)
+
+	// The whitespace is kept too.
+	result, err = english.T.Transliterate(" (no)  Zzxq;\tyes. ")
+	assert.NoError(t, err)
+	assert.Equal(t, " (NOW)  Zzxq;\tYEHS. ", result)
+
+	tokens := english.Analyze("a  b")
+	assert.Equal(t, 0, tokens[0].Offset)
+	assert.Equal(t, 3, tokens[1].Offset)
+
+	// The punctuation marks survive in Hangul.
+	spec, err := hangulize.ParseSpec(strings.NewReader(`
+	lang:
+		id       = "eng-punct"
+		codes    = "en", "eng"
+		translit = "english"
+
+	transcribe:
+		"n"   -> "ㄴ"
+		"ow"  -> "ㅗ"
+		"yeh" -> "ㅖ"
+		"s"   -> "ㅅ"
+	`))
+	require.NoError(t, err)
+
+	h := hangulize.New(spec)
+	h.UseTranslit(english.T)
+
+	result, err = h.Hangulize("No, yes!")
+	require.NoError(t, err)
+	assert.Equal(t, "노, 예스!", result)
+}
+
+func TestTransliterateSpans(t *testing.T) {
+	st := english.T.(hangulize.SpanTranslit)
+
+	result, spans, err := st.TransliterateSpans("Hello, (Zzxq) world!")
+	require.NoError(t, err)
+	assert.Equal(t, "HHAHLOW, (Zzxq) WERLD!", result)
+	assert.Equal(t, []hangulize.TranslitSpan{
+		{Start: 0, Stop: 5, OutStart: 0, OutStop: 7},
+		{Start: 8, Stop: 12, OutStart: 10, OutStop: 14},
+		{Start: 14, Stop: 19, OutStart: 16, OutStop: 21},
+	}, spans)
+}
+
+func TestPhraseToken(t *testing.T) {
+	result, err := english.T.Transliterate("I love ice cream in New\tYork's (hot dog).")
+	require.NoError(t, err)
+	assert.Equal(t, "AY LAHV AYSKRIYM IHN NUWYAORKS (HHAATDAOG).", result)
+
+	tokens := english.Analyze("ice cream")
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ice cream", tokens[0].Word)
+
+	// Not a phrase across punctuation marks.
+	result, err = english.T.Transliterate("ice, cream")
+	require.NoError(t, err)
+	assert.Equal(t, "AYS, KRIYM", result)
+
+	// The longest phrase in the user dictionary.
+	english.AddPronunciation("Quux  Frob", "K W AH1 K S F R AA1 B")
+	english.AddPronunciation("quux frob bar", "K W AH1 K S F R AA1 B B AA1 R")
+	result, err = english.T.Transliterate("quux frob bar quux frob")
+	require.NoError(t, err)
+	assert.Equal(t, "KWAHKSFRAABBAAR KWAHKSFRAAB", result)
+}
+
+func TestTypographicQuotes(t *testing.T) {
+	result, err := english.T.Transliterate("\u201cDon\u2019t,\u201d she said. \u2018It\u2019s John\u2019s.\u2019")
+	require.NoError(t, err)
+	assert.Equal(t, "\u201cDOWNT,\u201d SHIY SEHD. \u2018IHTS JHAANZ.\u2019", result)
+
+	// Non-breaking spaces separate words.
+	result, err = english.T.Transliterate("hello\u00a0world, New\u202fYork")
+	require.NoError(t, err)
+	assert.Equal(t, "HHAHLOW\u00a0WERLD, NUWYAORK", result)
+
+	tokens := english.Analyze("\u00abwon\u2019t\u00bb")
+	assert.True(t, tokens[0].Known)
+	assert.Equal(t, "WOWNT", tokens[0].Phonemes)
+}
@@ -0,0 +1,37 @@
+package english
+
+import (
+	"io"
+	"strings"
+)
+
+// AddPronunciation adds or corrects the pronunciation of a word in ARPAbet
+// separated by spaces, such as "AE1 N TH R AH0 P IH0 K" for "Anthropic". It
+// overrides the embedded dictionaries for all English Translits. It is safe
+// to call it concurrently with transliteration. Each call copies the user
+// dictionary, so LoadUserDict is preferred to add many pronunciations.
+//
+// The word may be a phrase of several words such as "ice cream". Then the
+// longest phrase in the input is pronounced as a single word.
+func AddPronunciation(word, arpabet string) {
+	key := strings.Join(strings.Fields(strings.ToLower(word)), " ")
+	addUser(map[string]string{
+		key: strings.Join(strings.Fields(arpabet), " "),
+	})
+}
+
+// LoadUserDict adds the pronunciations in the CMUdict format from r like
+// AddPronunciation:
+//
+//	;;; comments
+//	ANTHROPIC  AE1 N TH R AH0 P IH0 K
+//	HANGULIZE  HH AA1 NG G UW0 L AY2 Z
+//	ICE CREAM  AY1 S K R IY2 M
+func LoadUserDict(r io.Reader) error {
+	dict, err := loadDictionary(r)
+	if err != nil {
+		return err
+	}
+	addUser(dict)
+	return nil
+}
@@ -0,0 +1,40 @@
+package english_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestAddPronunciation(t *testing.T) {
+	result, err := english.T.Transliterate("Zorblatt")
+	require.NoError(t, err)
+	assert.Equal(t, "Zorblatt", result)
+
+	english.AddPronunciation("Zorblatt", "Z AO1 R  B L AE2 T")
+
+	result, err = english.T.Transliterate("Zorblatt")
+	require.NoError(t, err)
+	assert.Equal(t, "ZAORBLAET", result)
+}
+
+func TestLoadUserDict(t *testing.T) {
+	err := english.LoadUserDict(strings.NewReader(`;;; Corrections
+QUUXLY  K W AH1 K S L IY0
+LIMA  L AY1 M AH0
+`))
+	require.NoError(t, err)
+
+	result, err := english.T.Transliterate("quuxly")
+	require.NoError(t, err)
+	assert.Equal(t, "KWAHKSLIY", result)
+
+	// The user dictionary overrides the embedded ones even for proper nouns.
+	result, err = english.T.Transliterate("in Lima")
+	require.NoError(t, err)
+	assert.Equal(t, "IHN LAYMAH", result)
+}
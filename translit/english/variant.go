@@ -0,0 +1,120 @@
+package english
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Chooser chooses a pronunciation among the variants of a word in CMUdict,
+// such as "R IY1 D" and "R EH1 D" for "read". The variants are in ARPAbet
+// with stress numbers in the order of CMUdict.
+type Chooser func(word string, variants []string) string
+
+// FirstVariant chooses the first variant in CMUdict. It is the default.
+func FirstVariant(word string, variants []string) string {
+	return variants[0]
+}
+
+// MostCommonVariant chooses the variant which shares the most phonemes with
+// the others. CMUdict doesn't have the frequencies of the variants, so the
+// central one stands for the most common pronunciation.
+func MostCommonVariant(word string, variants []string) string {
+	best, bestScore := 0, -1
+	for i, a := range variants {
+		score := 0
+		for j, b := range variants {
+			if i != j {
+				score += commonPhonemes(strings.Fields(a), strings.Fields(b))
+			}
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return variants[best]
+}
+
+// commonPhonemes counts the phonemes in the longest common subsequence.
+func commonPhonemes(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// Heteronym is a word with multiple pronunciations in its context, such as
+// "lead" in "lead the team" or "wind" in "the wind blows".
+type Heteronym struct {
+	// Words are the words in the text as they are in the input. Index is the
+	// index of the heteronym in Words.
+	Words []string
+	Index int
+
+	// Variants are the pronunciations in ARPAbet with stress numbers in the
+	// order of CMUdict.
+	Variants []string
+}
+
+// Disambiguate sets the function which chooses a pronunciation of a
+// heteronym by its context, such as with a part-of-speech tagger. It returns
+// one of the variants. It takes precedence over ChooseVariant.
+func Disambiguate(fn func(Heteronym) string) Option {
+	return func(p *english) { p.disambiguate = fn }
+}
+
+// ChooseVariant sets the Chooser for the words with multiple pronunciations.
+// It is FirstVariant by default.
+func ChooseVariant(c Chooser) Option {
+	return func(p *english) { p.chooser = c }
+}
+
+// Pronunciations returns all the variants of a word in the embedded CMUdict
+// in ARPAbet, such as ["R IY1 D", "R EH1 D"] for "read". A pronunciation
+// added by AddPronunciation replaces them. It returns nil for an unknown
+// word.
+func Pronunciations(word string) []string {
+	s := loadSnapshot()
+
+	key := strings.ToLower(word)
+	if pron, ok := s.user[key]; ok {
+		return []string{pron}
+	}
+	return variants(s.dict, key)
+}
+
+// variants finds the pronunciations of a lowercase word in a dictionary. The
+// variants are keyed by the word with a number, such as "read(1)" or
+// "read(2)".
+func variants(dict *dictionary, key string) []string {
+	if dict == nil {
+		return nil
+	}
+
+	pron, ok := dict.lookup(key)
+	if !ok {
+		return nil
+	}
+
+	prons := []string{pron}
+	for n := 1; ; n++ {
+		pron, ok := dict.lookup(key + "(" + strconv.Itoa(n) + ")")
+		if !ok {
+			// Some versions of CMUdict number the second variant 2.
+			if n == 1 {
+				continue
+			}
+			break
+		}
+		prons = append(prons, pron)
+	}
+	return prons
+}
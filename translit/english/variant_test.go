@@ -0,0 +1,58 @@
+package english_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/english"
+)
+
+func TestPronunciations(t *testing.T) {
+	assert.Equal(t, []string{"B AE1 S", "B EY1 S"}, english.Pronunciations("Bass"))
+	assert.Equal(t, []string{"HH AH0 L OW1", "HH EH0 L OW1"}, english.Pronunciations("hello"))
+	assert.Nil(t, english.Pronunciations("Zzxq"))
+}
+
+func TestChooseVariant(t *testing.T) {
+	result, err := english.T.Transliterate("bass")
+	require.NoError(t, err)
+	assert.Equal(t, "BAES", result)
+
+	last := english.New(english.ChooseVariant(func(word string, variants []string) string {
+		return variants[len(variants)-1]
+	}))
+	result, err = last.Transliterate("bass")
+	require.NoError(t, err)
+	assert.Equal(t, "BEYS", result)
+}
+
+func TestMostCommonVariant(t *testing.T) {
+	assert.Equal(t, "T AH0 M EY1 T OW2", english.MostCommonVariant("tomato", []string{
+		"T AH0 M AA1 T OW2",
+		"T AH0 M EY1 T OW2",
+		"T AH0 M EY1 T OW0",
+	}))
+	assert.Equal(t, "R IY1 D", english.MostCommonVariant("read", []string{"R IY1 D", "R EH1 D"}))
+}
+
+func TestDisambiguate(t *testing.T) {
+	assert.Equal(t, []string{"L EH1 D", "L IY1 D"}, english.Pronunciations("lead"))
+
+	// A naive tagger: "lead" after "to" is a verb.
+	tr := english.New(english.Disambiguate(func(h english.Heteronym) string {
+		if h.Words[h.Index] == "lead" && h.Index > 0 && h.Words[h.Index-1] == "to" {
+			return "L IY1 D"
+		}
+		return h.Variants[0]
+	}))
+
+	result, err := tr.Transliterate("to lead")
+	require.NoError(t, err)
+	assert.Equal(t, "TUW LIYD", result)
+
+	result, err = tr.Transliterate("of lead")
+	require.NoError(t, err)
+	assert.Equal(t, "AHV LEHD", result)
+}
@@ -2,12 +2,25 @@
 Package furigana implements the hangulize.Translit interface for Japanese
 Kanji. Kanji has very broad characters so they need a dictionary to be
 converted to Kana. This Translit uses IPADIC in Kagome to analyze Kanji.
+
+Another Tokenizer can be chosen by New. MeCab is available with the "mecab"
+build tag:
+
+	tok, err := furigana.NewMeCab("")
+	hangulize.UseTranslit(furigana.New(tok))
+
+Personal names are read by an embedded name dictionary before the Tokenizer
+guesses, e.g., "羽生結弦" is read as "ハニュウ ユヅル" rather than "ハブ". A
+name which is a single word is read so only where the Tokenizer tags it as a
+person name. FindNames tells which names have ambiguous readings.
+
+House styles other than the standard rules for long vowels and geminations
+can be chosen by LongVowels and Sokuon.
 */
 package furigana
 
 import (
 	"github.com/hangulize/hangulize"
-	kagome "github.com/ikawaha/kagome.ipadic/tokenizer"
 	"golang.org/x/text/unicode/norm"
 )
 
@@ -17,21 +30,46 @@ var T hangulize.Translit = &furigana{}
 // ----------------------------------------------------------------------------
 
 type furigana struct {
-	kagome *kagome.Tokenizer
+	tokenizer Tokenizer
+	longVowel LongVowelPolicy
+	sokuon    SokuonPolicy
+	names     map[string][]string
+}
+
+// New creates a Translit for Furigana with a Tokenizer and options. Kagome is
+// used if the Tokenizer is nil.
+func New(tokenizer Tokenizer, opts ...Option) hangulize.Translit {
+	p := &furigana{tokenizer: tokenizer}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (furigana) Scheme() string {
 	return "furigana"
 }
 
-// ensureKagome caches a Kagome tokenizer because it is expensive.
-func (p *furigana) ensureKagome() *kagome.Tokenizer {
-	if p.kagome == nil {
+// Datasets reports the dictionary of the Tokenizer. It is IPADIC in Kagome
+// by default. A custom Tokenizer reports nothing unless it has the Datasets
+// method like hangulize.DatasetTranslit.
+func (p *furigana) Datasets() []hangulize.Dataset {
+	switch tok := p.tokenizer.(type) {
+	case nil:
+		return kagomeDatasets()
+	case interface{ Datasets() []hangulize.Dataset }:
+		return tok.Datasets()
+	}
+	return nil
+}
+
+// ensureTokenizer caches a Kagome tokenizer because it is expensive.
+func (p *furigana) ensureTokenizer() Tokenizer {
+	if p.tokenizer == nil {
 		// It may take a while.
-		k := kagome.New()
-		p.kagome = &k
+		p.tokenizer = NewKagome()
 	}
-	return p.kagome
+	return p.tokenizer
 }
 
 func (p *furigana) Transliterate(word string) (string, error) {
@@ -41,11 +79,13 @@ func (p *furigana) Transliterate(word string) (string, error) {
 	// Resolve Kana repeatations.
 	word = repeatKana(word)
 
-	k := p.ensureKagome()
-	tokens := k.Tokenize(word)
+	tokens := p.ensureTokenizer().Tokenize(word)
+	tokens = p.readNames(tokens, nil)
 
 	tw := newTypewriter(tokens)
 	word = tw.Typewrite()
 
+	word = p.applyPolicies(word)
+
 	return word, nil
 }
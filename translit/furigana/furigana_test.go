@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hangulize/hangulize"
 	"github.com/hangulize/hangulize/translit/furigana"
 )
 
@@ -59,3 +60,140 @@ func TestAmbiguousLinkedShortVowels(t *testing.T) {
 func TestLongVowelAcrossMorphemes(t *testing.T) {
 	assert.Equal(t, "ハナサナカロー", mustTransliterate(t, "話さなかろう"))
 }
+
+type stubTokenizer struct{}
+
+func (stubTokenizer) Tokenize(text string) []furigana.Token {
+	return []furigana.Token{
+		{Surface: "東京", Features: []string{
+			"名詞", "固有名詞", "地域", "一般", "*", "*", "東京", "トウキョウ", "ヒガシキョー",
+		}},
+		{Surface: "?", Features: nil},
+	}
+}
+
+func TestTokenizer(t *testing.T) {
+	tr := furigana.New(stubTokenizer{})
+	assert.Equal(t, "furigana", tr.Scheme())
+
+	result, err := tr.Transliterate("東京")
+	require.NoError(t, err)
+	assert.Equal(t, "ヒガシキョー?", result)
+
+	result, err = furigana.New(nil).Transliterate("東京")
+	require.NoError(t, err)
+	assert.Equal(t, "トーキョー", result)
+}
+
+// datasetTokenizer is a stubTokenizer which reports its dictionary.
+type datasetTokenizer struct {
+	stubTokenizer
+}
+
+func (datasetTokenizer) Datasets() []hangulize.Dataset {
+	return []hangulize.Dataset{{Name: "stubdic", Version: "1.0"}}
+}
+
+func TestTokenizerDatasets(t *testing.T) {
+	datasets := func(tr hangulize.Translit) []hangulize.Dataset {
+		return tr.(hangulize.DatasetTranslit).Datasets()
+	}
+
+	// Kagome is the default.
+	require.Len(t, datasets(furigana.T), 1)
+	assert.Equal(t, "IPADIC", datasets(furigana.T)[0].Name)
+	assert.Equal(t, datasets(furigana.T), datasets(furigana.New(furigana.NewKagome())))
+
+	// The dictionary of a custom Tokenizer is unknown unless it reports.
+	assert.Empty(t, datasets(furigana.New(stubTokenizer{})))
+	assert.Equal(t,
+		[]hangulize.Dataset{{Name: "stubdic", Version: "1.0"}},
+		datasets(furigana.New(datasetTokenizer{})),
+	)
+}
+
+func TestLongVowelPolicies(t *testing.T) {
+	repeat := furigana.New(nil, furigana.LongVowels(furigana.LongVowelRepeat))
+
+	result, err := repeat.Transliterate("大阪")
+	require.NoError(t, err)
+	assert.Equal(t, "オオサカ", result)
+
+	result, err = repeat.Transliterate("スーパー")
+	require.NoError(t, err)
+	assert.Equal(t, "スウパア", result)
+}
+
+func TestSokuonPolicies(t *testing.T) {
+	omit := furigana.New(nil, furigana.Sokuon(furigana.SokuonOmit))
+
+	result, err := omit.Transliterate("北海道")
+	require.NoError(t, err)
+	assert.Equal(t, "ホカイドー", result)
+
+	double := furigana.New(nil, furigana.Sokuon(furigana.SokuonDouble))
+
+	result, err = double.Transliterate("北海道")
+	require.NoError(t, err)
+	assert.Equal(t, "ホㇰカイドー", result)
+
+	result, err = double.Transliterate("ザッソー")
+	require.NoError(t, err)
+	assert.Equal(t, "ザッソー", result)
+}
+
+func TestPoliciesInSpec(t *testing.T) {
+	spec, err := hangulize.LoadSpec("jpn")
+	require.NoError(t, err)
+
+	h := hangulize.New(spec)
+	h.UseTranslit(furigana.New(nil,
+		furigana.LongVowels(furigana.LongVowelRepeat),
+		furigana.Sokuon(furigana.SokuonDouble),
+	))
+
+	result, err := h.Hangulize("大阪")
+	require.NoError(t, err)
+	assert.Equal(t, "오오사카", result)
+
+	result, err = h.Hangulize("北海道")
+	require.NoError(t, err)
+	assert.Equal(t, "혹카이도오", result)
+
+	result, err = h.Hangulize("札幌")
+	require.NoError(t, err)
+	assert.Equal(t, "삽포로", result)
+}
+
+func TestNameReadings(t *testing.T) {
+	assert.Equal(t, "ハニュー ユヅル", mustTransliterate(t, "羽生結弦"))
+	assert.Equal(t, "ハブ ヨシハル", mustTransliterate(t, "羽生善治"))
+	assert.Equal(t, "タカナシ", mustTransliterate(t, "小鳥遊"))
+
+	p := furigana.New(nil, furigana.NameReadings(map[string]string{
+		"羽生": "ハブ",
+	}))
+	result, err := p.Transliterate("羽生")
+	require.NoError(t, err)
+	assert.Equal(t, "ハブ", result)
+}
+
+func TestFindNames(t *testing.T) {
+	names := furigana.FindNames("羽生と羽生結弦")
+	require.Len(t, names, 2)
+
+	assert.Equal(t, "羽生", names[0].Name)
+	assert.Equal(t, "ハニュウ", names[0].Reading)
+	assert.True(t, names[0].Ambiguous())
+
+	assert.Equal(t, "羽生結弦", names[1].Name)
+	assert.False(t, names[1].Ambiguous())
+
+	// A common noun is not a name even if it is read as a name elsewhere.
+	p := furigana.New(nil, furigana.NameReadings(map[string]string{
+		"樹": "イツキ",
+	}))
+	result, err := p.Transliterate("樹の下")
+	require.NoError(t, err)
+	assert.Equal(t, "キノシタ", result)
+}
@@ -0,0 +1,105 @@
+//go:build mecab && cgo
+
+package furigana
+
+/*
+#cgo LDFLAGS: -lmecab
+#include <stdlib.h>
+#include <mecab.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/hangulize/hangulize"
+)
+
+type mecabTokenizer struct {
+	mu     sync.Mutex
+	tagger *C.mecab_t
+}
+
+// NewMeCab creates a Tokenizer backed by MeCab through cgo. The args are
+// passed to MeCab as is, e.g., "-d /usr/lib/mecab/dic/ipadic". The
+// dictionary should be IPADIC or compatible with it.
+//
+// It is available only with the "mecab" build tag.
+func NewMeCab(args string) (Tokenizer, error) {
+	cArgs := C.CString(args)
+	defer C.free(unsafe.Pointer(cArgs))
+
+	tagger := C.mecab_new2(cArgs)
+	if tagger == nil {
+		return nil, errors.New("mecab: " + C.GoString(C.mecab_strerror(nil)))
+	}
+
+	t := &mecabTokenizer{tagger: tagger}
+	runtime.SetFinalizer(t, func(t *mecabTokenizer) {
+		C.mecab_destroy(t.tagger)
+	})
+	return t, nil
+}
+
+func (t *mecabTokenizer) Tokenize(text string) []Token {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var tokens []Token
+
+	node := C.mecab_sparse_tonode(t.tagger, cText)
+	for ; node != nil; node = node.next {
+		switch node.stat {
+		case C.MECAB_BOS_NODE, C.MECAB_EOS_NODE:
+			continue
+		}
+
+		surface := C.GoStringN(node.surface, C.int(node.length))
+
+		// MeCab skips whitespaces before a morpheme. They are counted in
+		// rlength. Keep them as an unknown token like Kagome.
+		if n := node.rlength - node.length; n > 0 {
+			head := (*C.char)(unsafe.Add(unsafe.Pointer(node.surface), -int(n)))
+			tokens = append(tokens, Token{C.GoStringN(head, C.int(n)), nil})
+		}
+
+		var features []string
+		if node.stat == C.MECAB_NOR_NODE {
+			features = strings.Split(C.GoString(node.feature), ",")
+		}
+		tokens = append(tokens, Token{surface, features})
+	}
+
+	runtime.KeepAlive(t)
+	return tokens
+}
+
+// Datasets reports the dictionaries loaded by MeCab. Each dictionary is named
+// after its directory, e.g., "ipadic" for "/usr/lib/mecab/dic/ipadic/sys.dic".
+func (t *mecabTokenizer) Datasets() []hangulize.Dataset {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var datasets []hangulize.Dataset
+
+	info := C.mecab_dictionary_info(t.tagger)
+	for ; info != nil; info = info.next {
+		dir := filepath.Dir(C.GoString(info.filename))
+		datasets = append(datasets, hangulize.Dataset{
+			Name:    "MeCab " + filepath.Base(dir),
+			Version: strconv.Itoa(int(info.version)),
+		})
+	}
+
+	runtime.KeepAlive(t)
+	return datasets
+}
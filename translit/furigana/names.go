@@ -0,0 +1,168 @@
+package furigana
+
+import (
+	"bufio"
+	_ "embed" // Required for go:embed
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:embed names.txt
+var namesData string
+
+// maxNameLength is the number of letters in the longest name to look up.
+const maxNameLength = 8
+
+var (
+	nameDict     map[string][]string
+	nameDictOnce sync.Once
+)
+
+// NameReadings adds readings of personal names. The readings are Katakana
+// separated by "|" and the first one is preferred, e.g., "ハニュウ|ハブ" for
+// "羽生". A space in a reading splits a full name.
+//
+// The readings take precedence over the embedded name dictionary.
+func NameReadings(dict map[string]string) Option {
+	return func(p *furigana) {
+		if p.names == nil {
+			p.names = make(map[string][]string)
+		}
+		for name, readings := range dict {
+			p.names[name] = strings.Split(readings, "|")
+		}
+	}
+}
+
+// NameReading is a personal name read by the name dictionary.
+type NameReading struct {
+	Name string
+
+	// Reading is the preferred reading in Katakana.
+	Reading string
+
+	// Alternatives are the other readings of the name. The reading is
+	// ambiguous if there are any alternatives.
+	Alternatives []string
+}
+
+// Ambiguous reports whether the name has alternative readings.
+func (r NameReading) Ambiguous() bool {
+	return len(r.Alternatives) != 0
+}
+
+// FindNames finds the personal names in a text which are read by the name
+// dictionary. It tells whether the readings are ambiguous.
+func FindNames(text string) []NameReading {
+	p := T.(*furigana)
+
+	var found []NameReading
+	p.readNames(p.ensureTokenizer().Tokenize(text), func(r NameReading) {
+		found = append(found, r)
+	})
+	return found
+}
+
+// loadNameDict parses the embedded name dictionary once.
+func loadNameDict() {
+	nameDictOnce.Do(func() {
+		nameDict = make(map[string][]string)
+
+		scanner := bufio.NewScanner(strings.NewReader(namesData))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			fields := strings.SplitN(line, " ", 2)
+			if len(fields) == 2 {
+				nameDict[fields[0]] = strings.Split(fields[1], "|")
+			}
+		}
+	})
+}
+
+// lookupName finds the readings of a name.
+func (p *furigana) lookupName(name string) ([]string, bool) {
+	if readings, ok := p.names[name]; ok {
+		return readings, true
+	}
+	loadNameDict()
+	readings, ok := nameDict[name]
+	return readings, ok
+}
+
+// isPersonName reports whether the tokens may be read as a name in the name
+// dictionary. A full name and a name split into several tokens are always
+// read as a name. But a single known word should be tagged as a person name
+// so that a common noun like "樹" in "樹の下" keeps the reading by the
+// Tokenizer.
+func isPersonName(tokens []Token, readings []string) bool {
+	if strings.Contains(readings[0], " ") || len(tokens) > 1 {
+		return true
+	}
+
+	tok := tokens[0]
+	if !tok.known() {
+		return true
+	}
+	return tok.Features[1] == "固有名詞" && tok.Features[2] == "人名"
+}
+
+// readNames replaces the tokens which form a personal name in the name
+// dictionary with tokens of person names. The longest name wins. found is
+// called for each name if not nil.
+func (p *furigana) readNames(tokens []Token, found func(NameReading)) []Token {
+	var result []Token
+
+	for i := 0; i < len(tokens); {
+		var (
+			name     string
+			readings []string
+			n        int
+		)
+
+		surface := ""
+		for j := i; j < len(tokens); j++ {
+			surface += tokens[j].Surface
+			if utf8.RuneCountInString(surface) > maxNameLength {
+				break
+			}
+			if r, ok := p.lookupName(surface); ok && isPersonName(tokens[i:j+1], r) {
+				name, readings, n = surface, r, j-i+1
+			}
+		}
+
+		if n == 0 {
+			result = append(result, tokens[i])
+			i++
+			continue
+		}
+
+		if found != nil {
+			found(NameReading{name, readings[0], readings[1:]})
+		}
+
+		// A full name is split into the family name and the given name.
+		for k, reading := range strings.Fields(readings[0]) {
+			surface := ""
+			if k == 0 {
+				surface = name
+			}
+			result = append(result, personNameToken(surface, reading))
+		}
+		i += n
+	}
+
+	return result
+}
+
+// personNameToken makes a token for a person name with the IPADIC features.
+func personNameToken(surface, reading string) Token {
+	pronunciation := mergeLongVowels(reading, 0)
+	return Token{surface, []string{
+		"名詞", "固有名詞", "人名", "一般", "*", "*", surface, reading, pronunciation,
+	}}
+}
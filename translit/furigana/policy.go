@@ -0,0 +1,124 @@
+package furigana
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Option customizes a Translit made by New.
+type Option func(*furigana)
+
+// LongVowelPolicy decides how "ー" long vowels are spelled out.
+type LongVowelPolicy int
+
+const (
+	// LongVowelOmit leaves "ー" to the spec which omits it as the standard
+	// rule: "オーサカ" -> "오사카".
+	LongVowelOmit LongVowelPolicy = iota
+
+	// LongVowelRepeat spells "ー" as the previous vowel: "オーサカ" ->
+	// "オオサカ" -> "오오사카".
+	LongVowelRepeat
+)
+
+// SokuonPolicy decides how "ッ" geminations are spelled out.
+type SokuonPolicy int
+
+const (
+	// SokuonSiot leaves "ッ" to the spec which writes it as a final "ㅅ" as
+	// the standard rule: "ホッカイドー" -> "홋카이도".
+	SokuonSiot SokuonPolicy = iota
+
+	// SokuonOmit drops "ッ": "ホッカイドー" -> "호카이도".
+	SokuonOmit
+
+	// SokuonDouble doubles the following consonant in a final: "ホッカイドー"
+	// -> "혹카이도", "サッポロ" -> "삽포로". "ッ" before the other consonants
+	// remains as a final "ㅅ".
+	//
+	// The doubled "k" and "p" are written in "ㇰ" and "ㇷ" which are the small
+	// Katakana for final consonants.
+	SokuonDouble
+)
+
+// LongVowels chooses a policy for "ー" long vowels.
+//
+// It takes precedence over hangulize.LongVowels. LongVowelRepeat spells "ー"
+// out before the spec is applied, so hangulize.LongVowels finds no long
+// vowel. With LongVowelOmit, "ー" is left to hangulize.LongVowels.
+func LongVowels(policy LongVowelPolicy) Option {
+	return func(p *furigana) { p.longVowel = policy }
+}
+
+// Sokuon chooses a policy for "ッ" geminations.
+func Sokuon(policy SokuonPolicy) Option {
+	return func(p *furigana) { p.sokuon = policy }
+}
+
+// vowelRows groups Katakana by their vowels.
+var vowelRows = map[rune]string{
+	'ア': "ァアカガサザタダナハバパマャヤラヮワヵ",
+	'イ': "ィイキギシジチヂニヒビピミリヰ",
+	'ウ': "ゥウクグスズツヅヌフブプムュユルヴ",
+	'エ': "ェエケゲセゼテデネヘベペメレヱヶ",
+	'オ': "ォオコゴソゾトドノホボポモョヨロヲ",
+}
+
+// vowelOf finds the vowel of a Katakana. It returns 0 if the Katakana has no
+// vowel such as "ン".
+func vowelOf(ch rune) rune {
+	for vowel, row := range vowelRows {
+		if strings.ContainsRune(row, ch) {
+			return vowel
+		}
+	}
+	return 0
+}
+
+// smallFinals are the small Katakana for the doubled consonants.
+var smallFinals = map[rune]string{
+	'ㇰ': "カキクケコガギグゲゴ",
+	'ㇷ': "パピプペポバビブベボ",
+}
+
+// applyPolicies rewrites "ー" and "ッ" in a pronunciation by the policies.
+func (p *furigana) applyPolicies(word string) string {
+	if p.longVowel == LongVowelOmit && p.sokuon == SokuonSiot {
+		return word
+	}
+
+	var buf bytes.Buffer
+
+	chars := []rune(word)
+	for i, ch := range chars {
+		switch {
+
+		case ch == 'ー' && p.longVowel == LongVowelRepeat:
+			if i != 0 {
+				if vowel := vowelOf(chars[i-1]); vowel != 0 {
+					ch = vowel
+					// Remember the vowel for the next "ー".
+					chars[i] = vowel
+				}
+			}
+
+		case ch == 'ッ' && p.sokuon == SokuonOmit:
+			continue
+
+		case ch == 'ッ' && p.sokuon == SokuonDouble:
+			if i+1 < len(chars) {
+				for final, row := range smallFinals {
+					if strings.ContainsRune(row, chars[i+1]) {
+						ch = final
+						break
+					}
+				}
+			}
+
+		}
+
+		buf.WriteRune(ch)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,74 @@
+package furigana
+
+import (
+	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/internal/buildinfo"
+	kagome "github.com/ikawaha/kagome.ipadic/tokenizer"
+)
+
+// Tokenizer is a backend of morphological analysis. It splits a Japanese
+// text into morphemes with IPADIC features. The default is Kagome which is
+// written in pure Go. MeCab is also available with the "mecab" build tag.
+//
+// A Tokenizer may also have the Datasets method like
+// hangulize.DatasetTranslit to report its dictionary.
+type Tokenizer interface {
+	Tokenize(text string) []Token
+}
+
+// Token is a morpheme analyzed by a Tokenizer.
+type Token struct {
+	Surface string
+
+	// Features are the IPADIC features of a known word: part-of-speech,
+	// sub-class 1-3, inflection, conjugation, root-form, reading, and
+	// pronunciation. It is nil if the word is unknown to the dictionary.
+	Features []string
+}
+
+// known reports whether the token has the full IPADIC features.
+func (tok Token) known() bool {
+	return len(tok.Features) >= 9
+}
+
+// ----------------------------------------------------------------------------
+
+type kagomeTokenizer struct {
+	k kagome.Tokenizer
+}
+
+// NewKagome creates a Tokenizer backed by Kagome with IPADIC. It may take a
+// while to load the dictionary.
+func NewKagome() Tokenizer {
+	return &kagomeTokenizer{kagome.New()}
+}
+
+// Datasets reports the version of IPADIC in Kagome.
+func (t *kagomeTokenizer) Datasets() []hangulize.Dataset {
+	return kagomeDatasets()
+}
+
+func kagomeDatasets() []hangulize.Dataset {
+	return []hangulize.Dataset{{
+		Name:    "IPADIC",
+		Version: buildinfo.ModuleVersion("github.com/ikawaha/kagome.ipadic"),
+	}}
+}
+
+func (t *kagomeTokenizer) Tokenize(text string) []Token {
+	var tokens []Token
+
+	for _, tok := range t.k.Tokenize(text) {
+		switch tok.Class {
+		case kagome.DUMMY:
+			// BOS and EOS.
+			continue
+		case kagome.KNOWN:
+			tokens = append(tokens, Token{tok.Surface, tok.Features()})
+		default:
+			tokens = append(tokens, Token{tok.Surface, nil})
+		}
+	}
+
+	return tokens
+}
@@ -4,8 +4,6 @@ import (
 	"bytes"
 	"strings"
 	"unicode/utf8"
-
-	kagome "github.com/ikawaha/kagome.ipadic/tokenizer"
 )
 
 type category int
@@ -21,20 +19,20 @@ const (
 	unknown
 )
 
-// typewriter writes a whole pronunciation from the Kagome tokens.
+// typewriter writes a whole pronunciation from the tokens.
 type typewriter struct {
-	tokens  []kagome.Token
+	tokens  []Token
 	result  string
 	cur     int
 	lastCat category
 }
 
-// newTypewriter initializes a typewriter for the Kagome tokens.
-func newTypewriter(tokens []kagome.Token) *typewriter {
+// newTypewriter initializes a typewriter for the tokens.
+func newTypewriter(tokens []Token) *typewriter {
 	return &typewriter{tokens, "", -1, illegal}
 }
 
-// Typewrite returns a whole pronunciation from the Kagome tokens.
+// Typewrite returns a whole pronunciation from the tokens.
 func (t *typewriter) Typewrite() string {
 	// Re-use the cached result if already processed.
 	if t.cur != -1 {
@@ -57,7 +55,7 @@ func (t *typewriter) Typewrite() string {
 	return t.result
 }
 
-// scanMorpheme consumes the Kagome tokens one by one.
+// scanMorpheme consumes the tokens one by one.
 func (t *typewriter) scanMorpheme() (sep string, str string) {
 	var buf bytes.Buffer
 
@@ -71,7 +69,7 @@ func (t *typewriter) scanMorpheme() (sep string, str string) {
 
 	str, cat := interpretToken(tok)
 
-	// Merge long vowels in an unknown word. Because Kagome didn't detect the
+	// Merge long vowels in an unknown word. Because the tokenizer didn't detect the
 	// pronunciation of this word.
 	if cat == unknown {
 		str = mergeLongVowels(str, 0)
@@ -120,33 +118,26 @@ func (t *typewriter) scanMorpheme() (sep string, str string) {
 	return sep, str
 }
 
-func (t *typewriter) read() *kagome.Token {
-	var tok *kagome.Token
-
-	// Scan the next non-dummy token.
-	for tok == nil || tok.Class == kagome.DUMMY {
-		t.cur++
-
-		if t.cur >= len(t.tokens) {
-			return nil
-		}
+func (t *typewriter) read() *Token {
+	t.cur++
 
-		tok = &t.tokens[t.cur]
+	if t.cur >= len(t.tokens) {
+		return nil
 	}
 
-	return tok
+	return &t.tokens[t.cur]
 }
 
 func (t *typewriter) unread() {
 	t.cur--
 }
 
-// interpretToken picks a pronunciation and category from a Kagome token.
-func interpretToken(tok *kagome.Token) (string, category) {
+// interpretToken picks a pronunciation and category from a token.
+func interpretToken(tok *Token) (string, category) {
 	str := tok.Surface
 	cat := unknown
 
-	if tok.Class == kagome.KNOWN {
+	if tok.known() {
 		// 0: part-of-speech
 		// 1: sub-class 1
 		// 2: sub-class 2
@@ -156,7 +147,7 @@ func interpretToken(tok *kagome.Token) (string, category) {
 		// 6: root-form
 		// 7: reading
 		// 8: pronunciation
-		fs := tok.Features()
+		fs := tok.Features
 		var (
 			partOfSpeech  = fs[0]
 			subClass1     = fs[1]
@@ -3,26 +3,25 @@ package furigana
 import (
 	"testing"
 
-	kagome "github.com/ikawaha/kagome.ipadic/tokenizer"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestTypewriterAuxiliary(t *testing.T) {
-	toks := kagome.New().Tokenize("食べよう")
+	toks := NewKagome().Tokenize("食べよう")
 	tw := newTypewriter(toks)
 
 	assert.Equal(t, "タベヨー", tw.Typewrite())
 }
 
 func TestTypewriterUnknown(t *testing.T) {
-	toks := kagome.New().Tokenize("ホウオウ")
+	toks := NewKagome().Tokenize("ホウオウ")
 	tw := newTypewriter(toks)
 
 	assert.Equal(t, "ホーオー", tw.Typewrite())
 }
 
 func TestTypewriterParticles(t *testing.T) {
-	toks := kagome.New().Tokenize("それはあちへ")
+	toks := NewKagome().Tokenize("それはあちへ")
 	tw := newTypewriter(toks)
 
 	// ハ instead of ワ, ヘ instead of エ
@@ -30,7 +29,7 @@ func TestTypewriterParticles(t *testing.T) {
 }
 
 func TestTypewriterReuse(t *testing.T) {
-	toks := kagome.New().Tokenize("東京")
+	toks := NewKagome().Tokenize("東京")
 	tw := newTypewriter(toks)
 
 	assert.Equal(t, -1, tw.cur)
@@ -0,0 +1,123 @@
+package pinyin
+
+import (
+	"bufio"
+	_ "embed" // Required for go:embed
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:embed t2s.txt
+var t2sData string
+
+//go:embed phrases.txt
+var phrasesData string
+
+var (
+	t2s      map[rune]rune
+	phrases  map[string][]string
+	dictOnce sync.Once
+
+	// maxPhraseLen is the number of the characters in the longest phrase in
+	// the embedded dictionary.
+	maxPhraseLen int
+)
+
+// Simplify chooses whether to convert traditional Chinese characters into
+// the simplified forms before looking up the Pinyin. It is enabled by
+// default. The Pinyin is the same but more phrases are detected because the
+// phrases are written in simplified Chinese.
+func Simplify(enabled bool) Option {
+	return func(p *pinyin) { p.keepTraditional = !enabled }
+}
+
+// Phrases adds phrases to disambiguate polyphonic characters, such as "重" in
+// "重庆" (chong qing) and "重要" (zhong yao). The Pinyin of a phrase consists
+// of syllables separated by spaces, one for each character. A phrase with a
+// wrong number of syllables is ignored.
+//
+// The phrases take precedence over the embedded dictionary.
+func Phrases(dict map[string]string) Option {
+	return func(p *pinyin) {
+		loadDicts()
+
+		if p.phrases == nil {
+			p.phrases = make(map[string][]string)
+		}
+		for word, pyn := range dict {
+			chars := []rune(word)
+			syllables := strings.Fields(pyn)
+			if len(chars) != len(syllables) {
+				continue
+			}
+			p.phrases[string(simplify(chars))] = syllables
+			p.maxPhraseLen = max(p.maxPhraseLen, len(chars))
+		}
+	}
+}
+
+// loadDicts parses the embedded dictionaries once.
+func loadDicts() {
+	dictOnce.Do(func() {
+		t2s = make(map[rune]rune)
+		eachLine(t2sData, func(fields []string) {
+			trad, simp := []rune(fields[0]), []rune(fields[1])
+			t2s[trad[0]] = simp[0]
+		})
+
+		phrases = make(map[string][]string)
+		eachLine(phrasesData, func(fields []string) {
+			phrases[fields[0]] = fields[1:]
+			maxPhraseLen = max(maxPhraseLen, utf8.RuneCountInString(fields[0]))
+		})
+	})
+}
+
+// eachLine calls fn with the fields of each line in a dictionary except
+// comments and blank lines.
+func eachLine(data string, fn func([]string)) {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) >= 2 {
+			fn(fields)
+		}
+	}
+}
+
+// simplify converts traditional Chinese characters into the simplified
+// forms. The other letters remain.
+func simplify(chars []rune) []rune {
+	simplified := make([]rune, len(chars))
+	for i, ch := range chars {
+		if simp, ok := t2s[ch]; ok {
+			ch = simp
+		}
+		simplified[i] = ch
+	}
+	return simplified
+}
+
+// matchPhrase finds the longest phrase at i in the user dictionary or the
+// embedded dictionary. It returns the Pinyin syllables of the phrase.
+func (p *pinyin) matchPhrase(chars []rune, i int) []string {
+	// Don't try the candidates longer than any phrase. Otherwise, a long
+	// input would take quadratic time at each character.
+	end := min(len(chars), i+max(maxPhraseLen, p.maxPhraseLen))
+
+	for j := end; j > i+1; j-- {
+		word := string(chars[i:j])
+
+		if syllables, ok := p.phrases[word]; ok {
+			return syllables
+		}
+		if syllables, ok := phrases[word]; ok {
+			return syllables
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package pinyin
+
+import (
+	"strings"
+)
+
+// ErhuaPolicy decides how an Erhua (儿化), the "儿" suffix of the Beijing
+// dialect, is spelled out.
+type ErhuaPolicy int
+
+const (
+	// ErhuaRhotacize merges "儿" into the previous syllable as a rhotacized
+	// final: "哪儿" -> "nar", "一点儿" -> "yidiar".
+	ErhuaRhotacize ErhuaPolicy = iota
+
+	// ErhuaStrip drops "儿": "哪儿" -> "na".
+	ErhuaStrip
+
+	// ErhuaSyllable keeps "儿" as an independent syllable: "哪儿" -> "naer".
+	ErhuaSyllable
+)
+
+// Erhua chooses a policy for the Erhua suffixes.
+func Erhua(policy ErhuaPolicy) Option {
+	return func(p *pinyin) { p.erhua = policy }
+}
+
+// syllabicErhua are the words which end with "儿" as a full syllable rather
+// than a suffix.
+var syllabicErhua = map[rune]bool{
+	'女': true, '婴': true, '嬰': true, '孤': true, '幼': true, '健': true,
+	'男': true, '胎': true, '患': true, '宠': true, '寵': true,
+}
+
+// erhuaHeads are the letters which make a word with a following "儿", such
+// as "儿子" or "儿童".
+var erhuaHeads = map[rune]bool{
+	'子': true, '童': true, '女': true, '科': true, '歌': true, '戏': true,
+	'戲': true, '孙': true, '孫': true, '媳': true,
+}
+
+// isErhua reports whether the letter at i is an Erhua suffix. Only "儿"
+// after a Hanzi is a suffix.
+func isErhua(chars []rune, i int, hanzi func(rune) bool) bool {
+	if chars[i] != '儿' && chars[i] != '兒' {
+		return false
+	}
+	if i == 0 || !hanzi(chars[i-1]) || syllabicErhua[chars[i-1]] {
+		return false
+	}
+	if i+1 < len(chars) && erhuaHeads[chars[i+1]] {
+		return false
+	}
+	return true
+}
+
+// rhotacize merges "r" into a Pinyin syllable. The final of the syllable
+// changes as it sounds in the Beijing dialect.
+func rhotacize(syllable string) string {
+	if isApical(syllable) {
+		// "zhi" -> "zher"
+		return strings.TrimSuffix(syllable, "i") + "er"
+	}
+
+	// The nasal final and the "-i" after a vowel are dropped.
+	switch {
+	case strings.HasSuffix(syllable, "ng"):
+		syllable = strings.TrimSuffix(syllable, "ng")
+	case strings.HasSuffix(syllable, "n"):
+		syllable = strings.TrimSuffix(syllable, "n")
+	case strings.HasSuffix(syllable, "ai"), strings.HasSuffix(syllable, "ei"):
+		syllable = strings.TrimSuffix(syllable, "i")
+	case strings.HasSuffix(syllable, "ui"):
+		syllable = strings.TrimSuffix(syllable, "i") + "o"
+	}
+
+	// "we" alone is not a syllable but "wo" is.
+	if syllable == "we" {
+		syllable = "wo"
+	}
+
+	return syllable + "r"
+}
+
+// isApical reports whether the syllable has an apical vowel "-i" such as
+// "zi" or "zhi".
+func isApical(syllable string) bool {
+	switch syllable {
+	case "zi", "ci", "si", "zhi", "chi", "shi", "ri":
+		return true
+	}
+	return false
+}
@@ -2,6 +2,12 @@
 Package pinyin implements the hangulize.Translit interface for Chinese Hanzu.
 Hanzu has very broad characters so they need a dictionary to be converted to a
 phonogram.
+
+Polyphonic characters are disambiguated by a dictionary of phrases in
+simplified Chinese. Traditional characters are simplified before the lookup.
+
+The Erhua suffixes are merged into the previous syllables by default. Another
+policy can be chosen by New with Erhua.
 */
 package pinyin
 
@@ -10,46 +16,130 @@ import (
 	"strings"
 
 	"github.com/hangulize/hangulize"
+	"github.com/hangulize/hangulize/internal/buildinfo"
 	goPinyin "github.com/mozillazg/go-pinyin"
 	"golang.org/x/text/unicode/norm"
 )
 
 // T is a hangulize.Translit for Pinyin.
-var T hangulize.Translit = &pinyin{}
+var T hangulize.Translit = New()
 
 // ----------------------------------------------------------------------------
 
-type pinyin struct{}
+type pinyin struct {
+	erhua           ErhuaPolicy
+	keepTraditional bool
+	phrases         map[string][]string
+	maxPhraseLen    int
+	tones           bool
+}
+
+// Option customizes a Translit made by New.
+type Option func(*pinyin)
+
+// New creates a Translit for Pinyin with options.
+func New(opts ...Option) hangulize.Translit {
+	p := &pinyin{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
 
 func (pinyin) Scheme() string {
 	return "pinyin"
 }
 
+// Datasets reports the version of the Pinyin dictionary in go-pinyin.
+func (pinyin) Datasets() []hangulize.Dataset {
+	return []hangulize.Dataset{{
+		Name:    "go-pinyin",
+		Version: buildinfo.ModuleVersion("github.com/mozillazg/go-pinyin"),
+	}}
+}
+
 func (p *pinyin) Transliterate(word string) (string, error) {
 	// Normalize into CJK unified ideographs.
 	word = norm.NFC.String(word)
 
 	// Pick Pinyin.
-	var chunks []string
+	var (
+		chunks []string
+
+		// The Hanzi and its tone of each chunk. They are zero for a chunk
+		// of non-Hanzi letters.
+		heads []rune
+		tones []int
+	)
 	var buf bytes.Buffer
 
+	flush := func() {
+		if buf.Len() != 0 {
+			chunks = append(chunks, buf.String())
+			heads = append(heads, 0)
+			tones = append(tones, 0)
+			buf.Reset()
+		}
+	}
+
+	push := func(ch rune, syllable string) {
+		flush()
+		chunks = append(chunks, syllable)
+		heads = append(heads, ch)
+		if p.tones {
+			tones = append(tones, toneOf(ch, syllable))
+		} else {
+			tones = append(tones, 0)
+		}
+	}
+
 	a := goPinyin.NewArgs()
 
-	for _, ch := range word {
+	hanzi := func(ch rune) bool {
+		return len(goPinyin.SinglePinyin(ch, a)) != 0
+	}
+
+	loadDicts()
+
+	chars := []rune(word)
+	if !p.keepTraditional {
+		chars = simplify(chars)
+	}
+
+	for i := 0; i < len(chars); i++ {
+		ch := chars[i]
+
+		if p.erhua != ErhuaSyllable && isErhua(chars, i, hanzi) {
+			if p.erhua == ErhuaRhotacize {
+				last := len(chunks) - 1
+				chunks[last] = rhotacize(chunks[last])
+			}
+			continue
+		}
+
+		if syllables := p.matchPhrase(chars, i); syllables != nil {
+			for j, syllable := range syllables {
+				push(chars[i+j], syllable)
+			}
+			i += len(syllables) - 1
+			continue
+		}
+
 		pyn := goPinyin.SinglePinyin(ch, a)
 
 		if len(pyn) == 0 {
 			buf.WriteRune(ch)
 		} else {
-			if buf.Len() != 0 {
-				chunks = append(chunks, buf.String())
-				buf.Reset()
-			}
-			chunks = append(chunks, pyn[0])
+			push(ch, pyn[0])
 		}
 	}
-	if buf.Len() != 0 {
-		chunks = append(chunks, buf.String())
+	flush()
+
+	if p.tones {
+		applySandhi(heads, tones)
+		for i, tone := range tones {
+			chunks[i] = markTone(chunks[i], tone)
+		}
 	}
 
 	// U+200B: Zero Width Space
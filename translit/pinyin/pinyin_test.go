@@ -1,6 +1,7 @@
 package pinyin_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hangulize/hangulize/translit/pinyin"
@@ -31,3 +32,96 @@ func TestNonHanzi(t *testing.T) {
 func TestHanziAndNonHanzi(t *testing.T) {
 	assert.Equal(t, "아\u200bpin\u200byin\u200bAbc", mustTransliterate(t, "아拼音Abc"))
 }
+
+func TestErhua(t *testing.T) {
+	assert.Equal(t, "nar", mustTransliterate(t, "哪儿"))
+	assert.Equal(t, "yi\u200bdiar", mustTransliterate(t, "一点儿"))
+	assert.Equal(t, "sher", mustTransliterate(t, "事儿"))
+	assert.Equal(t, "kor", mustTransliterate(t, "空儿"))
+	assert.Equal(t, "huor", mustTransliterate(t, "会儿"))
+
+	// Not a suffix.
+	assert.Equal(t, "er\u200bzi", mustTransliterate(t, "儿子"))
+	assert.Equal(t, "nv\u200ber", mustTransliterate(t, "女儿"))
+	assert.Equal(t, "er", mustTransliterate(t, "儿"))
+}
+
+func TestErhuaPolicies(t *testing.T) {
+	strip := pinyin.New(pinyin.Erhua(pinyin.ErhuaStrip))
+	result, err := strip.Transliterate("哪儿")
+	require.NoError(t, err)
+	assert.Equal(t, "na", result)
+
+	syllable := pinyin.New(pinyin.Erhua(pinyin.ErhuaSyllable))
+	result, err = syllable.Transliterate("哪儿")
+	require.NoError(t, err)
+	assert.Equal(t, "na\u200ber", result)
+}
+
+func TestPolyphones(t *testing.T) {
+	assert.Equal(t, "chong\u200bqing", mustTransliterate(t, "重庆"))
+	assert.Equal(t, "zhong\u200byao", mustTransliterate(t, "重要"))
+
+	// The phrases are detected in traditional Chinese too.
+	assert.Equal(t, "chong\u200bqing", mustTransliterate(t, "重慶"))
+	assert.Equal(t, "xia\u200bmen", mustTransliterate(t, "廈門"))
+}
+
+func TestSimplify(t *testing.T) {
+	traditional := pinyin.New(pinyin.Simplify(false))
+	result, err := traditional.Transliterate("重慶")
+	require.NoError(t, err)
+	assert.Equal(t, "zhong\u200bqing", result)
+}
+
+func TestPhrases(t *testing.T) {
+	p := pinyin.New(pinyin.Phrases(map[string]string{
+		"重樂": "chong yue",
+		"乐":  "yue yue",
+	}))
+
+	result, err := p.Transliterate("重乐")
+	require.NoError(t, err)
+	assert.Equal(t, "chong\u200byue", result)
+
+	// A phrase with a wrong number of syllables is ignored.
+	result, err = p.Transliterate("乐")
+	require.NoError(t, err)
+	assert.Equal(t, "le", result)
+}
+
+func TestLongInput(t *testing.T) {
+	// It takes linear time. A long input should not hang.
+	word := strings.Repeat("重庆重要", 5000)
+	expected := strings.Repeat("chong\u200bqing\u200bzhong\u200byao\u200b", 5000)
+	assert.Equal(t, strings.TrimSuffix(expected, "\u200b"), mustTransliterate(t, word))
+
+	// The phrases by the option can be longer than the embedded phrases.
+	p := pinyin.New(pinyin.Phrases(map[string]string{
+		"乐乐乐乐乐乐乐乐乐乐": "yue yue yue yue yue yue yue yue yue yue",
+	}))
+	result, err := p.Transliterate(strings.Repeat("乐", 10))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("yue\u200b", 9)+"yue", result)
+}
+
+func TestTones(t *testing.T) {
+	p := pinyin.New(pinyin.Tones(true))
+
+	cases := map[string]string{
+		"你好":  "ní\u200bhǎo",
+		"展览馆": "zhán\u200blán\u200bguǎn",
+		"不是":  "bú\u200bshì",
+		"不好":  "bù\u200bhǎo",
+		"一样":  "yí\u200byàng",
+		"一天":  "yì\u200btiān",
+		"第一":  "dì\u200byī",
+		"吕燕":  "lǚ\u200byàn",
+		"的":   "de",
+	}
+	for word, expected := range cases {
+		result, err := p.Transliterate(word)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, word)
+	}
+}
@@ -0,0 +1,101 @@
+package pinyin
+
+import (
+	"strings"
+
+	goPinyin "github.com/mozillazg/go-pinyin"
+)
+
+// Tones chooses whether to write tone marks on syllables, e.g., "nǐ". The
+// tones are not the citation tones but the tones in actual pronunciation
+// after the tone sandhi:
+//
+//   - A third tone before another third tone becomes the second tone:
+//     "你好" -> "níhǎo".
+//   - "不" before a fourth tone becomes the second tone: "不是" -> "búshì".
+//   - "一" before a fourth tone becomes the second tone and before the other
+//     tones becomes the fourth tone: "一样" -> "yíyàng", "一天" -> "yìtiān".
+//
+// The neutral tone has no mark. Specs for Latin script ignore the tone marks
+// as other diacritics.
+func Tones(enabled bool) Option {
+	return func(p *pinyin) { p.tones = enabled }
+}
+
+// toneOf finds the citation tone of a Hanzi read as a syllable without tone.
+// It returns 0 for the neutral tone.
+func toneOf(ch rune, syllable string) int {
+	a := goPinyin.NewArgs()
+	a.Style = goPinyin.Tone3
+	a.Heteronym = true
+
+	for _, pyn := range goPinyin.SinglePinyin(ch, a) {
+		n := len(pyn) - 1
+		if n > 0 && pyn[:n] == syllable && '1' <= pyn[n] && pyn[n] <= '4' {
+			return int(pyn[n] - '0')
+		}
+	}
+	return 0
+}
+
+// applySandhi changes the citation tones into the tones in actual
+// pronunciation. The heads are the Hanzi of the syllables.
+func applySandhi(heads []rune, tones []int) {
+	for i := range tones {
+		next := 0
+		if i+1 < len(tones) {
+			next = tones[i+1]
+		}
+		if next == 0 {
+			continue
+		}
+
+		switch {
+		case heads[i] == '不' && tones[i] == 4 && next == 4:
+			tones[i] = 2
+
+		case heads[i] == '一' && tones[i] == 1:
+			if next == 4 {
+				tones[i] = 2
+			} else {
+				tones[i] = 4
+			}
+
+		case tones[i] == 3 && next == 3:
+			tones[i] = 2
+		}
+	}
+}
+
+// toneMarks are the vowels with the tone marks from the first tone to the
+// fourth tone.
+var toneMarks = map[rune][4]rune{
+	'a': {'ā', 'á', 'ǎ', 'à'},
+	'e': {'ē', 'é', 'ě', 'è'},
+	'i': {'ī', 'í', 'ǐ', 'ì'},
+	'o': {'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'ū', 'ú', 'ǔ', 'ù'},
+	'v': {'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+}
+
+// markTone puts a tone mark on a syllable. The mark goes on "a" or "e" if
+// any, on "o" of "ou", or else on the last vowel.
+func markTone(syllable string, tone int) string {
+	if tone < 1 || tone > 4 {
+		return syllable
+	}
+
+	i := strings.IndexAny(syllable, "ae")
+	if i == -1 {
+		i = strings.Index(syllable, "ou")
+	}
+	if i == -1 {
+		i = strings.LastIndexAny(syllable, "iouv")
+	}
+	if i == -1 {
+		return syllable
+	}
+
+	mark := toneMarks[rune(syllable[i])][tone-1]
+	return syllable[:i] + string(mark) + syllable[i+1:]
+}
@@ -0,0 +1,188 @@
+/*
+Package romaji implements the hangulize.Translit interface for Japanese
+written in Latin letters. It converts Romaji into Katakana so that the Japanese
+spec transcribes "Shinjuku" as same as "シンジュク" or "新宿". Latin words
+which are not valid Romaji, such as "iPhone", remain as is.
+
+Both of Hepburn and Kunrei-shiki are accepted. They differ only in a few
+syllables such as "ti" and "tu" which are chosen by the System.
+*/
+package romaji
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/hangulize/hangulize"
+	"golang.org/x/text/unicode/norm"
+)
+
+// T is a hangulize.Translit for Romaji in Hepburn.
+var T hangulize.Translit = New(Hepburn)
+
+// System is a Romanization system of Japanese.
+type System int
+
+const (
+	// Hepburn reads "ti" as "ティ" and "tu" as "トゥ". The Kunrei-shiki
+	// spellings which don't conflict with Hepburn, such as "si" or "syo", are
+	// also accepted.
+	Hepburn System = iota
+
+	// Kunrei reads "ti" as "チ" and "tu" as "ツ". Hepburn spellings such as
+	// "shi" or "chi" are also accepted.
+	Kunrei
+)
+
+// New creates a Translit for Romaji in a System.
+func New(system System) hangulize.Translit {
+	return &romaji{system}
+}
+
+// ----------------------------------------------------------------------------
+
+type romaji struct {
+	system System
+}
+
+func (romaji) Scheme() string {
+	return "romaji"
+}
+
+// maxSyllable is the length of the longest syllable in the table.
+const maxSyllable = 3
+
+// Transliterate converts each run of Latin letters into Katakana. A run which
+// is not a valid Romaji, such as "abc", remains as is.
+func (r *romaji) Transliterate(word string) (string, error) {
+	word = norm.NFC.String(word)
+
+	var buf strings.Builder
+	var run []rune
+
+	flush := func() {
+		if len(run) != 0 {
+			buf.WriteString(r.convert(string(run)))
+			run = run[:0]
+		}
+	}
+
+	for _, ch := range word {
+		if isRomaji(ch) {
+			run = append(run, ch)
+		} else {
+			flush()
+			buf.WriteRune(ch)
+		}
+	}
+	flush()
+
+	return buf.String(), nil
+}
+
+// convert converts a run of Latin letters into Katakana. It returns the run
+// as is if it is not a valid Romaji.
+func (r *romaji) convert(run string) string {
+	chars := []rune(expandLongVowels(run))
+
+	var buf strings.Builder
+
+	for i := 0; i < len(chars); {
+		ch := unicode.ToLower(chars[i])
+
+		if ch == 'ー' {
+			buf.WriteRune(ch)
+			i++
+			continue
+		}
+
+		next := rune(0)
+		if i+1 < len(chars) {
+			next = unicode.ToLower(chars[i+1])
+		}
+
+		// Sokuon: a doubled consonant such as "kk" or "tch".
+		if isGeminable(ch) && (next == ch || ch == 't' && next == 'c') {
+			buf.WriteRune('ッ')
+			i++
+			continue
+		}
+
+		// Syllabic "n": "n" not before a vowel or "y", or "m" before a
+		// labial consonant as "Shimbashi".
+		if ch == 'n' && !isVowel(next) && next != 'y' ||
+			ch == 'm' && (next == 'b' || next == 'm' || next == 'p') {
+			buf.WriteRune('ン')
+			i++
+			if next == '\'' {
+				i++
+			}
+			continue
+		}
+
+		kana, n := r.matchSyllable(chars[i:])
+		if n == 0 {
+			return run
+		}
+
+		buf.WriteString(kana)
+		i += n
+	}
+
+	return buf.String()
+}
+
+// matchSyllable finds the longest syllable at the head of the letters.
+func (r *romaji) matchSyllable(chars []rune) (string, int) {
+	for n := maxSyllable; n > 0; n-- {
+		if n > len(chars) {
+			continue
+		}
+
+		syllable := strings.ToLower(string(chars[:n]))
+
+		if r.system == Kunrei {
+			if kana, ok := kunreiSyllables[syllable]; ok {
+				return kana, n
+			}
+		}
+		if kana, ok := syllables[syllable]; ok {
+			return kana, n
+		}
+	}
+	return "", 0
+}
+
+// expandLongVowels replaces vowels with a macron or circumflex, such as "ō"
+// or "ô", with the vowels followed by "ー".
+func expandLongVowels(word string) string {
+	word = norm.NFD.String(word)
+
+	var buf strings.Builder
+	for _, ch := range word {
+		if ch == '̄' || ch == '̂' {
+			// U+0304: Combining Macron, U+0302: Combining Circumflex Accent
+			buf.WriteRune('ー')
+		} else {
+			buf.WriteRune(ch)
+		}
+	}
+
+	return norm.NFC.String(buf.String())
+}
+
+// isRomaji reports whether a letter may be a part of Romaji.
+func isRomaji(ch rune) bool {
+	ch = unicode.ToLower(ch)
+	return 'a' <= ch && ch <= 'z' || ch == '\'' ||
+		strings.ContainsRune("āīūēōâîûêô", ch)
+}
+
+func isVowel(ch rune) bool {
+	return strings.ContainsRune("aiueo", ch)
+}
+
+// isGeminable reports whether a consonant can be doubled by a sokuon.
+func isGeminable(ch rune) bool {
+	return strings.ContainsRune("kgsztdhbpfcjrvw", ch)
+}
@@ -0,0 +1,61 @@
+package romaji_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hangulize/hangulize/translit/romaji"
+)
+
+func mustTransliterate(t *testing.T, word string) string {
+	result, err := romaji.T.Transliterate(word)
+	require.NoError(t, err)
+	return result
+}
+
+func TestHepburn(t *testing.T) {
+	assert.Equal(t, "シンジュク", mustTransliterate(t, "Shinjuku"))
+	assert.Equal(t, "チガサキ", mustTransliterate(t, "Chigasaki"))
+	assert.Equal(t, "ツクバ", mustTransliterate(t, "Tsukuba"))
+	assert.Equal(t, "フジ", mustTransliterate(t, "Fuji"))
+	assert.Equal(t, "ティ", mustTransliterate(t, "ti"))
+}
+
+func TestKunrei(t *testing.T) {
+	assert.Equal(t, "シンジュク", mustTransliterate(t, "Sinzyuku"))
+	assert.Equal(t, "フジ", mustTransliterate(t, "Huzi"))
+
+	kunrei := romaji.New(romaji.Kunrei)
+	result, err := kunrei.Transliterate("Tigasaki Tukuba")
+	require.NoError(t, err)
+	assert.Equal(t, "チガサキ ツクバ", result)
+}
+
+func TestSyllabicN(t *testing.T) {
+	assert.Equal(t, "ギンザ", mustTransliterate(t, "Ginza"))
+	assert.Equal(t, "オンナ", mustTransliterate(t, "onna"))
+	assert.Equal(t, "キンヨービ", mustTransliterate(t, "Kin'yōbi"))
+	assert.Equal(t, "キニョービ", mustTransliterate(t, "Kinyōbi"))
+	assert.Equal(t, "シンバシ", mustTransliterate(t, "Shimbashi"))
+}
+
+func TestSokuon(t *testing.T) {
+	assert.Equal(t, "サッポロ", mustTransliterate(t, "Sapporo"))
+	assert.Equal(t, "マッチャ", mustTransliterate(t, "matcha"))
+}
+
+func TestLongVowels(t *testing.T) {
+	assert.Equal(t, "トーキョー", mustTransliterate(t, "Tōkyō"))
+	assert.Equal(t, "トーキョー", mustTransliterate(t, "Tôkyô"))
+}
+
+func TestNonRomaji(t *testing.T) {
+	assert.Equal(t, "新宿 123", mustTransliterate(t, "新宿 123"))
+}
+
+func TestInvalidRomaji(t *testing.T) {
+	assert.Equal(t, "abc", mustTransliterate(t, "abc"))
+	assert.Equal(t, "iPhone ト", mustTransliterate(t, "iPhone to"))
+}
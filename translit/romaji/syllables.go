@@ -0,0 +1,161 @@
+package romaji
+
+// syllables maps Romaji syllables into Katakana.
+var syllables = map[string]string{
+	"a":   "ア",
+	"i":   "イ",
+	"u":   "ウ",
+	"e":   "エ",
+	"o":   "オ",
+	"ka":  "カ",
+	"ki":  "キ",
+	"ku":  "ク",
+	"ke":  "ケ",
+	"ko":  "コ",
+	"ga":  "ガ",
+	"gi":  "ギ",
+	"gu":  "グ",
+	"ge":  "ゲ",
+	"go":  "ゴ",
+	"sa":  "サ",
+	"si":  "シ",
+	"su":  "ス",
+	"se":  "セ",
+	"so":  "ソ",
+	"za":  "ザ",
+	"zi":  "ジ",
+	"zu":  "ズ",
+	"ze":  "ゼ",
+	"zo":  "ゾ",
+	"ta":  "タ",
+	"ti":  "ティ",
+	"tu":  "トゥ",
+	"te":  "テ",
+	"to":  "ト",
+	"da":  "ダ",
+	"di":  "ディ",
+	"du":  "ドゥ",
+	"de":  "デ",
+	"do":  "ド",
+	"na":  "ナ",
+	"ni":  "ニ",
+	"nu":  "ヌ",
+	"ne":  "ネ",
+	"no":  "ノ",
+	"ha":  "ハ",
+	"hi":  "ヒ",
+	"hu":  "フ",
+	"he":  "ヘ",
+	"ho":  "ホ",
+	"ba":  "バ",
+	"bi":  "ビ",
+	"bu":  "ブ",
+	"be":  "ベ",
+	"bo":  "ボ",
+	"pa":  "パ",
+	"pi":  "ピ",
+	"pu":  "プ",
+	"pe":  "ペ",
+	"po":  "ポ",
+	"ma":  "マ",
+	"mi":  "ミ",
+	"mu":  "ム",
+	"me":  "メ",
+	"mo":  "モ",
+	"ra":  "ラ",
+	"ri":  "リ",
+	"ru":  "ル",
+	"re":  "レ",
+	"ro":  "ロ",
+	"la":  "ラ",
+	"li":  "リ",
+	"lu":  "ル",
+	"le":  "レ",
+	"lo":  "ロ",
+	"ya":  "ヤ",
+	"yu":  "ユ",
+	"ye":  "イェ",
+	"yo":  "ヨ",
+	"wa":  "ワ",
+	"wi":  "ウィ",
+	"we":  "ウェ",
+	"wo":  "ヲ",
+	"fa":  "ファ",
+	"fi":  "フィ",
+	"fu":  "フ",
+	"fe":  "フェ",
+	"fo":  "フォ",
+	"va":  "ヴァ",
+	"vi":  "ヴィ",
+	"vu":  "ヴ",
+	"ve":  "ヴェ",
+	"vo":  "ヴォ",
+	"ja":  "ジャ",
+	"ji":  "ジ",
+	"ju":  "ジュ",
+	"je":  "ジェ",
+	"jo":  "ジョ",
+	"sha": "シャ",
+	"shi": "シ",
+	"shu": "シュ",
+	"she": "シェ",
+	"sho": "ショ",
+	"cha": "チャ",
+	"chi": "チ",
+	"chu": "チュ",
+	"che": "チェ",
+	"cho": "チョ",
+	"tsa": "ツァ",
+	"tsi": "ツィ",
+	"tsu": "ツ",
+	"tse": "ツェ",
+	"tso": "ツォ",
+	"kya": "キャ",
+	"kyu": "キュ",
+	"kyo": "キョ",
+	"gya": "ギャ",
+	"gyu": "ギュ",
+	"gyo": "ギョ",
+	"nya": "ニャ",
+	"nyu": "ニュ",
+	"nyo": "ニョ",
+	"hya": "ヒャ",
+	"hyu": "ヒュ",
+	"hyo": "ヒョ",
+	"bya": "ビャ",
+	"byu": "ビュ",
+	"byo": "ビョ",
+	"pya": "ピャ",
+	"pyu": "ピュ",
+	"pyo": "ピョ",
+	"mya": "ミャ",
+	"myu": "ミュ",
+	"myo": "ミョ",
+	"rya": "リャ",
+	"ryu": "リュ",
+	"ryo": "リョ",
+	"sya": "シャ",
+	"syu": "シュ",
+	"syo": "ショ",
+	"zya": "ジャ",
+	"zyu": "ジュ",
+	"zyo": "ジョ",
+	"jya": "ジャ",
+	"jyu": "ジュ",
+	"jyo": "ジョ",
+	"tya": "チャ",
+	"tyu": "チュ",
+	"tyo": "チョ",
+	"cya": "チャ",
+	"cyu": "チュ",
+	"cyo": "チョ",
+	"dya": "ヂャ",
+	"dyu": "ヂュ",
+	"dyo": "ヂョ",
+}
+
+// kunreiSyllables overrides syllables in Kunrei-shiki.
+var kunreiSyllables = map[string]string{
+	"ti": "チ",
+	"tu": "ツ",
+}
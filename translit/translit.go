@@ -6,11 +6,13 @@ import (
 	"github.com/hangulize/hangulize/translit/english"
 	"github.com/hangulize/hangulize/translit/furigana"
 	"github.com/hangulize/hangulize/translit/pinyin"
+	"github.com/hangulize/hangulize/translit/romaji"
 )
 
 // Translits returns the standard Translits.
 func Translits() []hangulize.Translit {
-	ts := []hangulize.Translit{english.T, furigana.T, pinyin.T}
+	ts := []hangulize.Translit{furigana.T, pinyin.T, romaji.T}
+	ts = append(ts, english.Ts...)
 	ts = append(ts, cyrillic.Ts...)
 	return ts
 }
@@ -20,6 +20,8 @@ func TestInstallInstance(t *testing.T) {
 	translits = h.Translits()
 	assert.Contains(t, translits, "furigana")
 	assert.Contains(t, translits, "pinyin")
+	assert.Contains(t, translits, "english")
+	assert.Contains(t, translits, "english-uk")
 
 	ok = translit.Install(h)
 	assert.False(t, ok)
@@ -59,3 +61,16 @@ func TestInstallRollback(t *testing.T) {
 	assert.Contains(t, translits, "pinyin")
 	assert.Equal(t, fakePinyin, translits["pinyin"])
 }
+
+func TestDatasets(t *testing.T) {
+	var datasets []hangulize.Dataset
+	for _, tr := range translit.Translits() {
+		if dt, ok := tr.(hangulize.DatasetTranslit); ok {
+			datasets = append(datasets, dt.Datasets()...)
+		}
+	}
+
+	assert.Contains(t, datasets, hangulize.Dataset{Name: "CMUdict", Version: "0.7b"})
+	assert.Contains(t, datasets, hangulize.Dataset{Name: "IPADIC", Version: "v1.1.2"})
+	assert.Contains(t, datasets, hangulize.Dataset{Name: "go-pinyin", Version: "v0.19.0"})
+}
@@ -0,0 +1,100 @@
+package hangulize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// KoreanTypography makes a Hangulizer tidy up the result in the Korean
+// typographic conventions:
+//
+//   - Runs of whitespaces are collapsed into a space.
+//   - Foreign quotation marks, such as «» or „“, and straight quotes become
+//     the curly quotes: “” and ‘’.
+//   - Dashes, such as "–" or "―", and a hyphen between spaces become "—".
+//
+// Without this option, the original whitespaces and punctuations around
+// transcribed words are preserved as they are.
+func KoreanTypography(enabled bool) Option {
+	return func(o *options) {
+		o.koreanTypography = enabled
+	}
+}
+
+// typesetKorean tidies up a word in the Korean typographic conventions.
+func typesetKorean(word string) string {
+	var buf strings.Builder
+
+	runes := []rune(word)
+	doubleOpen, singleOpen := false, false
+
+	// atOpening reports whether a quote at i opens a quotation.
+	atOpening := func(i int) bool {
+		return i == 0 || unicode.IsSpace(runes[i-1]) || unicode.Is(unicode.Ps, runes[i-1])
+	}
+	spaced := func(i int) bool {
+		return (i == 0 || unicode.IsSpace(runes[i-1])) &&
+			(i == len(runes)-1 || unicode.IsSpace(runes[i+1]))
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			for i+1 < len(runes) && unicode.IsSpace(runes[i+1]) {
+				i++
+			}
+			buf.WriteRune(' ')
+
+		case r == '«' || r == '„':
+			doubleOpen = true
+			buf.WriteRune('“')
+
+		case r == '»':
+			doubleOpen = false
+			buf.WriteRune('”')
+
+		case r == '“' || r == '"':
+			if doubleOpen || !atOpening(i) && r == '"' {
+				doubleOpen = false
+				buf.WriteRune('”')
+			} else {
+				doubleOpen = true
+				buf.WriteRune('“')
+			}
+
+		case r == '‹' || r == '‚':
+			singleOpen = true
+			buf.WriteRune('‘')
+
+		case r == '›':
+			singleOpen = false
+			buf.WriteRune('’')
+
+		case r == '\'':
+			switch {
+			case atOpening(i):
+				singleOpen = true
+				buf.WriteRune('‘')
+			case singleOpen:
+				singleOpen = false
+				buf.WriteRune('’')
+			default:
+				// An apostrophe
+				buf.WriteRune(r)
+			}
+
+		case r == '–' || r == '―' || r == '‒':
+			buf.WriteRune('—')
+
+		case r == '-' && spaced(i):
+			buf.WriteRune('—')
+
+		default:
+			buf.WriteRune(r)
+		}
+	}
+
+	return buf.String()
+}
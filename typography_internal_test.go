@@ -0,0 +1,19 @@
+package hangulize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypesetKorean(t *testing.T) {
+	assert.Equal(t, "로마 밀라노", typesetKorean("로마 \t\n 밀라노"))
+	assert.Equal(t, "“로마”", typesetKorean("«로마»"))
+	assert.Equal(t, "“로마”", typesetKorean("„로마“"))
+	assert.Equal(t, "“로마” “밀라노”", typesetKorean(`"로마" "밀라노"`))
+	assert.Equal(t, "‘로마’", typesetKorean("‹로마›"))
+	assert.Equal(t, "‘로마’ 다르타냥'", typesetKorean("'로마' 다르타냥'"))
+	assert.Equal(t, "로마—밀라노", typesetKorean("로마–밀라노"))
+	assert.Equal(t, "로마 — 밀라노", typesetKorean("로마 - 밀라노"))
+	assert.Equal(t, "로마-밀라노", typesetKorean("로마-밀라노"))
+}
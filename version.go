@@ -0,0 +1,89 @@
+package hangulize
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hangulize/hangulize/internal/buildinfo"
+)
+
+// modulePath is the import path of this module.
+const modulePath = "github.com/hangulize/hangulize"
+
+// Version returns the version of Hangulize which the running binary has been
+// built with, such as "v0.5.0". It is "(devel)" for a build from a working
+// copy.
+func Version() string {
+	return buildinfo.ModuleVersion(modulePath)
+}
+
+// Checksum returns the SHA-256 digest of the HSL source in hex. The same
+// source always has the same checksum. So it identifies the exact revision
+// of the spec.
+//
+// If the rules have been modified by AddRule or RemoveRule, the rules are
+// digested too. So the checksum differs from the checksum of the source.
+func (s *Spec) Checksum() string {
+	h := sha256.New()
+	h.Write([]byte(s.Source))
+
+	if s.modified {
+		for _, rules := range [][]Rule{s.Rewrite, s.Transcribe} {
+			h.Write([]byte{0})
+			for _, rule := range rules {
+				fmt.Fprintln(h, rule)
+			}
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Modified reports whether the rules have been modified by AddRule or
+// RemoveRule. A modified spec cannot be reproduced from its source.
+func (s *Spec) Modified() bool {
+	return s.modified
+}
+
+// Provenance describes which code and data produce the transcriptions of a
+// Hangulizer. Services can report it for reproducibility audits.
+type Provenance struct {
+	// Version is the version of Hangulize.
+	Version string
+
+	// Lang is the language ID of the spec.
+	Lang string
+
+	// Checksum is the checksum of the spec.
+	Checksum string
+
+	// Modified is true if the rules of the spec have been modified at
+	// runtime. The spec cannot be reproduced from its source then.
+	Modified bool
+
+	// Datasets are the datasets which the Translits required by the spec rely
+	// on, in the order of the schemes in the spec.
+	Datasets []Dataset
+}
+
+// ProvenanceOf describes the provenance of the transcriptions of a
+// Hangulizer.
+func ProvenanceOf(h Hangulizer) Provenance {
+	spec := h.Spec()
+	p := Provenance{
+		Version:  Version(),
+		Lang:     spec.Lang.ID,
+		Checksum: spec.Checksum(),
+		Modified: spec.Modified(),
+	}
+
+	translits := h.Translits()
+	for _, scheme := range spec.Lang.Translit {
+		if t, ok := translits[scheme].(DatasetTranslit); ok {
+			p.Datasets = append(p.Datasets, t.Datasets()...)
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,73 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion(t *testing.T) {
+	assert.NotEmpty(t, hangulize.Version())
+}
+
+func TestChecksum(t *testing.T) {
+	a := mustParseSpec(bundleHSL)
+	b := mustParseSpec(bundleHSL)
+	c := mustParseSpec(bundleHSL + "\n")
+
+	assert.Len(t, a.Checksum(), 64)
+	assert.Equal(t, a.Checksum(), b.Checksum())
+	assert.NotEqual(t, a.Checksum(), c.Checksum())
+}
+
+func TestChecksumModified(t *testing.T) {
+	spec := mustParseSpec(bundleHSL)
+	h := hangulize.New(spec)
+	assert.False(t, hangulize.ProvenanceOf(h).Modified)
+
+	_, err := h.AddRule("transcribe", "x", "ㅋ")
+	require.NoError(t, err)
+
+	// The runtime rules are digested too.
+	p := hangulize.ProvenanceOf(h)
+	assert.True(t, p.Modified)
+	assert.NotEqual(t, spec.Checksum(), p.Checksum)
+	assert.False(t, spec.Modified())
+
+	// The same rules have the same checksum.
+	h2 := hangulize.New(mustParseSpec(bundleHSL))
+	_, err = h2.AddRule("transcribe", "x", "ㅋ")
+	require.NoError(t, err)
+	assert.Equal(t, p.Checksum, h2.Spec().Checksum())
+
+	// A removed rule changes the checksum.
+	require.True(t, h2.RemoveRule("transcribe", 0))
+	assert.NotEqual(t, p.Checksum, h2.Spec().Checksum())
+}
+
+type datasetTranslit struct {
+	stubTranslit
+}
+
+func (datasetTranslit) Datasets() []hangulize.Dataset {
+	return []hangulize.Dataset{{Name: "stubdict", Version: "1.0"}}
+}
+
+func TestProvenanceOf(t *testing.T) {
+	spec := mustParseSpec(`
+	lang:
+		id       = "test"
+		codes    = "xx", "xxx"
+		translit = "stub"
+	`)
+	h := hangulize.New(spec)
+	h.UseTranslit(&datasetTranslit{})
+
+	p := hangulize.ProvenanceOf(h)
+	assert.Equal(t, hangulize.Version(), p.Version)
+	assert.Equal(t, "test", p.Lang)
+	assert.Equal(t, spec.Checksum(), p.Checksum)
+	assert.Equal(t, []hangulize.Dataset{{Name: "stubdict", Version: "1.0"}}, p.Datasets)
+}
@@ -0,0 +1,229 @@
+package hangulize
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hangulize/hangulize/internal/jamo"
+)
+
+// WhyNot explains why a word has not been transcribed as expected.
+type WhyNot struct {
+	Word     string
+	Expected string
+	Result   string
+
+	// Traces are the tracing events of the actual transcription.
+	Traces []Trace
+
+	// Culprit is the rule which produced the divergence from the expected
+	// output. It is nil if no rule has been blamed.
+	Culprit *Rule
+
+	// CulpritStep is "Rewrite" or "Transcribe" where Culprit belongs to.
+	CulpritStep string
+
+	// Without is the result when Culprit is removed from the spec.
+	Without string
+
+	// Remedy is the rule which would have yielded the expected output if it
+	// had been applied right before Culprit. It is nil if there's no such
+	// rule or if Without is already as expected.
+	Remedy *Rule
+
+	// RemedyStep is "Rewrite" or "Transcribe" where Remedy belongs to.
+	RemedyStep string
+}
+
+func (w WhyNot) String() string {
+	var buf strings.Builder
+
+	fmt.Fprintf(&buf, "%#v -> %#v, expected: %#v", w.Word, w.Result, w.Expected)
+
+	if w.Result == w.Expected {
+		return buf.String()
+	}
+
+	if w.Culprit == nil {
+		buf.WriteString("\nno rule to blame")
+		return buf.String()
+	}
+
+	fmt.Fprintf(&buf, "\ndiverged by %s rule #%d: %s", w.CulpritStep, w.Culprit.ID, w.Culprit)
+	fmt.Fprintf(&buf, "\nwithout it: %#v", w.Without)
+
+	if w.Remedy != nil {
+		fmt.Fprintf(&buf, "\nexpected if %s rule #%d is applied before: %s",
+			w.RemedyStep, w.Remedy.ID, w.Remedy)
+	}
+
+	return buf.String()
+}
+
+// ExplainWhyNot transcribes a word and explains why the result is not the
+// expected output.
+//
+// Only the rules which have changed the word are suspected. A suspect is
+// blamed in the following order of precedence:
+//
+//  1. The expected output comes out without the suspect.
+//  2. The expected output comes out if a later rule is applied right before
+//     the suspect. The later rule is the remedy.
+//  3. The result comes the closest to the expected output without the
+//     suspect.
+func ExplainWhyNot(h Hangulizer, word, expected string) (*WhyNot, error) {
+	var traces []Trace
+
+	traced := variant(h, h.Spec())
+	traced.Trace(func(t Trace) {
+		traces = append(traces, t)
+	})
+
+	result, err := traced.Hangulize(word)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &WhyNot{Word: word, Expected: expected, Result: result, Traces: traces}
+	if result == expected {
+		return w, nil
+	}
+
+	// Collect the suspects in the order of the traces.
+	type suspect struct {
+		ref     RuleRef
+		rule    *Rule
+		without string
+	}
+	var suspects []suspect
+	seen := make(map[RuleRef]bool)
+
+	for _, t := range traces {
+		if t.Rule == nil {
+			continue
+		}
+
+		ref := RuleRef{t.Step, t.Rule.ID}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		without, err := variant(h, withoutRule(h.Spec(), ref)).Hangulize(word)
+		if err != nil {
+			continue
+		}
+		suspects = append(suspects, suspect{ref, t.Rule, without})
+	}
+
+	blame := func(s suspect) {
+		w.Culprit = s.rule
+		w.CulpritStep = s.ref.Step
+		w.Without = s.without
+	}
+
+	// 1. Removing the suspect is enough.
+	for _, s := range suspects {
+		if s.without == expected {
+			blame(s)
+			return w, nil
+		}
+	}
+
+	// 2. A later rule should have been applied before the suspect.
+	for _, s := range suspects {
+		rules := rulesOf(h.Spec(), s.ref.Step)
+
+		for i := s.ref.ID + 1; i < len(rules); i++ {
+			spec := withRuleMoved(h.Spec(), s.ref.Step, i, s.ref.ID)
+			moved, err := variant(h, spec).Hangulize(word)
+			if err != nil {
+				continue
+			}
+
+			if moved == expected {
+				blame(s)
+				w.Remedy = &rules[i]
+				w.RemedyStep = s.ref.Step
+				return w, nil
+			}
+		}
+	}
+
+	// 3. Removing the suspect brings the result the closest.
+	best := jamo.Distance(result, expected)
+	for _, s := range suspects {
+		if d := jamo.Distance(s.without, expected); d < best {
+			best = d
+			blame(s)
+		}
+	}
+
+	return w, nil
+}
+
+// variant creates a hangulizer for the spec with the same options and
+// translits as h. The tracing function of h is not inherited.
+func variant(h Hangulizer, spec *Spec) Hangulizer {
+	orig, ok := h.(*hangulizer)
+	if !ok {
+		v := New(spec)
+		for _, t := range h.Translits() {
+			v.UseTranslit(t)
+		}
+		return v
+	}
+
+	copied := *orig
+	copied.spec = spec
+	copied.ownSpec = false
+	copied.translitRegistry = translitRegistry(orig.translitRegistry.Detach())
+	copied.traceFunc = nil
+	return &copied
+}
+
+// rulesOf returns the rules in a step.
+func rulesOf(spec *Spec, step string) []Rule {
+	if step == "Rewrite" {
+		return spec.Rewrite
+	}
+	return spec.Transcribe
+}
+
+// withRules copies a spec with the rules in a step replaced.
+func withRules(spec *Spec, step string, rules []Rule) *Spec {
+	copied := *spec
+	if step == "Rewrite" {
+		copied.Rewrite = rules
+	} else {
+		copied.Transcribe = rules
+	}
+	return &copied
+}
+
+// withoutRule copies a spec without a rule.
+func withoutRule(spec *Spec, ref RuleRef) *Spec {
+	var rules []Rule
+	for _, rule := range rulesOf(spec, ref.Step) {
+		if rule.ID != ref.ID {
+			rules = append(rules, rule)
+		}
+	}
+	return withRules(spec, ref.Step, rules)
+}
+
+// withRuleMoved copies a spec with the i-th rule moved to the j-th position.
+func withRuleMoved(spec *Spec, step string, i, j int) *Spec {
+	orig := rulesOf(spec, step)
+
+	rules := make([]Rule, 0, len(orig))
+	rules = append(rules, orig[:j]...)
+	rules = append(rules, orig[i])
+	for k := j; k < len(orig); k++ {
+		if k != i {
+			rules = append(rules, orig[k])
+		}
+	}
+
+	return withRules(spec, step, rules)
+}
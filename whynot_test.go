@@ -0,0 +1,84 @@
+package hangulize_test
+
+import (
+	"testing"
+
+	"github.com/hangulize/hangulize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const whyNotHSL = `
+lang:
+    id     = "test"
+    codes  = "xx", "xxx"
+    script = "Latn"
+
+rewrite:
+    "c{~h}" -> "k"
+    "o$"  -> "u"
+    "ci"  -> "chi"
+
+transcribe:
+    "ch" -> "ㅊ"
+    "k"  -> "ㅋ"
+    "a"  -> "ㅏ"
+    "i"  -> "ㅣ"
+    "o"  -> "ㅗ"
+    "u"  -> "ㅜ"
+`
+
+func TestExplainWhyNotWithout(t *testing.T) {
+	h := hangulize.New(mustParseSpec(whyNotHSL))
+
+	w, err := hangulize.ExplainWhyNot(h, "coco", "코코")
+	require.NoError(t, err)
+
+	assert.Equal(t, "코쿠", w.Result)
+	require.NotNil(t, w.Culprit)
+	assert.Equal(t, "Rewrite", w.CulpritStep)
+	assert.Equal(t, 1, w.Culprit.ID)
+	assert.Equal(t, "코코", w.Without)
+	assert.Nil(t, w.Remedy)
+}
+
+func TestExplainWhyNotRemedy(t *testing.T) {
+	h := hangulize.New(mustParseSpec(whyNotHSL))
+
+	w, err := hangulize.ExplainWhyNot(h, "cica", "치카")
+	require.NoError(t, err)
+
+	assert.Equal(t, "키카", w.Result)
+	require.NotNil(t, w.Culprit)
+	assert.Equal(t, 0, w.Culprit.ID)
+	require.NotNil(t, w.Remedy)
+	assert.Equal(t, "Rewrite", w.RemedyStep)
+	assert.Equal(t, 2, w.Remedy.ID)
+
+	assert.Equal(t, `"cica" -> "키카", expected: "치카"
+diverged by Rewrite rule #0: "c{~h}" -> "k"
+without it: "치아"
+expected if Rewrite rule #2 is applied before: "ci" -> "chi"`, w.String())
+}
+
+func TestExplainWhyNotAsExpected(t *testing.T) {
+	h := hangulize.New(mustParseSpec(whyNotHSL))
+
+	w, err := hangulize.ExplainWhyNot(h, "ka", "카")
+	require.NoError(t, err)
+	assert.Equal(t, "카", w.Result)
+	assert.Nil(t, w.Culprit)
+}
+
+func TestExplainWhyNotWithOptions(t *testing.T) {
+	h := hangulize.New(mustParseSpec(whyNotHSL), hangulize.SyllableSeparator("·"))
+
+	w, err := hangulize.ExplainWhyNot(h, "coco", "코·코")
+	require.NoError(t, err)
+
+	// The variants without a rule are hangulized with the same options.
+	assert.Equal(t, "코·쿠", w.Result)
+	require.NotNil(t, w.Culprit)
+	assert.Equal(t, 1, w.Culprit.ID)
+	assert.Equal(t, "코·코", w.Without)
+}